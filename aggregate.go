@@ -0,0 +1,76 @@
+package goexceptions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// AGGREGATES: Several failures reported at once
+// ============================================================================
+
+// AggregateException groups several exceptions raised by independent operations,
+// such as the failures of a validation pass or of parallel work
+type AggregateException struct {
+	Message    string
+	Exceptions []*Exception
+}
+
+func (e AggregateException) Error() string {
+	messages := make([]string, 0, len(e.Exceptions))
+	for _, ex := range e.Exceptions {
+		messages = append(messages, ex.Error())
+	}
+	return fmt.Sprintf("AggregateException: %s (%d exceptions: %s)", e.Message, len(e.Exceptions), strings.Join(messages, "; "))
+}
+
+func (e AggregateException) TypeName() string {
+	return "AggregateException"
+}
+
+// Unwrap returns the grouped exceptions, so errors.Is and errors.As search each of
+// them
+func (e AggregateException) Unwrap() []error {
+	errs := make([]error, 0, len(e.Exceptions))
+	for _, ex := range e.Exceptions {
+		if ex != nil {
+			errs = append(errs, *ex)
+		}
+	}
+	return errs
+}
+
+// Flatten returns a copy where exceptions that are themselves AggregateExceptions
+// are replaced by the exceptions they group, at any depth
+func (e AggregateException) Flatten() AggregateException {
+	flat := AggregateException{Message: e.Message}
+	e.Range(func(ex *Exception) bool {
+		flat.Exceptions = append(flat.Exceptions, ex)
+		return true
+	})
+	return flat
+}
+
+// Range calls fn for each grouped exception, descending into nested
+// AggregateExceptions instead of visiting them, until fn returns false
+func (e AggregateException) Range(fn func(ex *Exception) bool) {
+	e.rangeDepth(fn, 0)
+}
+
+func (e AggregateException) rangeDepth(fn func(ex *Exception) bool, depth int) bool {
+	for _, ex := range e.Exceptions {
+		if ex == nil {
+			continue
+		}
+		if nested, ok := ex.Type.(AggregateException); ok && depth < maxRenderDepth {
+			if !nested.rangeDepth(fn, depth+1) {
+				return false
+			}
+			continue
+		}
+		if !fn(ex) {
+			return false
+		}
+	}
+	return true
+}
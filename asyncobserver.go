@@ -0,0 +1,195 @@
+package goexceptions
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// ============================================================================
+// ASYNC OBSERVERS: Keep slow reporters off the throw and handle path
+// ============================================================================
+
+// DropPolicy decides what an AsyncObserver does when its queue is full
+type DropPolicy int
+
+const (
+	// DropNewest discards the event being delivered
+	DropNewest DropPolicy = iota
+	// DropOldest discards the oldest queued event to make room
+	DropOldest
+	// BlockWhenFull waits for room, trading latency for completeness
+	BlockWhenFull
+)
+
+// AsyncConfig configures an AsyncObserver
+type AsyncConfig struct {
+	QueueSize int        // queued events before the drop policy applies, 1024 when zero
+	Drop      DropPolicy // DropNewest by default
+	OnDrop    func(Event)
+}
+
+// AsyncObserver delivers events to another observer from a background goroutine
+// through a bounded queue, so a slow reporter cannot add latency to the code that
+// throws and handles. Flush waits for the queue to drain; Close flushes and stops
+// the goroutine, after which events are delivered inline.
+type AsyncObserver struct {
+	observer Observer
+	config   AsyncConfig
+	queue    chan Event
+	stop     chan struct{}
+	stopped  chan struct{}
+	dropped  atomic.Int64
+
+	mu      sync.Mutex
+	pending int           // events accepted but not yet delivered
+	idle    chan struct{} // closed when pending drops to zero
+	closed  bool
+}
+
+// NewAsyncObserver starts an asynchronous dispatcher for observer. Register it with
+// AddObserver or WithObserver like any other observer.
+func NewAsyncObserver(observer Observer, config AsyncConfig) *AsyncObserver {
+	if config.QueueSize <= 0 {
+		config.QueueSize = 1024
+	}
+	ao := &AsyncObserver{
+		observer: observer,
+		config:   config,
+		queue:    make(chan Event, config.QueueSize),
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+	track(liveAsyncObservers, ao, true)
+	go ao.run()
+	return ao
+}
+
+// Observe queues the event. The exception is copied, so the event stays as it was
+// when handlers later change the exception.
+func (ao *AsyncObserver) Observe(event Event) {
+	if event.Exception != nil {
+		ex := *event.Exception
+		event.Exception = &ex
+	}
+
+	ao.mu.Lock()
+	if ao.closed {
+		ao.mu.Unlock()
+		deliver(ao.observer, event)
+		return
+	}
+	ao.accept()
+
+	if ao.config.Drop == BlockWhenFull {
+		ao.mu.Unlock()
+		ao.queue <- event
+		return
+	}
+
+	var dropped *Event
+	select {
+	case ao.queue <- event:
+	default:
+		if ao.config.Drop == DropOldest {
+			select {
+			case oldest := <-ao.queue:
+				dropped = &oldest
+			default:
+			}
+			ao.queue <- event
+		} else {
+			dropped = &event
+		}
+		if dropped != nil {
+			ao.release()
+		}
+	}
+	ao.mu.Unlock()
+
+	if dropped != nil {
+		ao.dropped.Add(1)
+		if ao.config.OnDrop != nil {
+			ao.config.OnDrop(*dropped)
+		}
+	}
+}
+
+// accept counts a queued event; the caller holds mu
+func (ao *AsyncObserver) accept() {
+	if ao.pending == 0 {
+		ao.idle = make(chan struct{})
+	}
+	ao.pending++
+}
+
+// release uncounts a delivered or dropped event; the caller holds mu
+func (ao *AsyncObserver) release() {
+	ao.pending--
+	if ao.pending == 0 {
+		close(ao.idle)
+	}
+}
+
+func (ao *AsyncObserver) run() {
+	defer close(ao.stopped)
+	for {
+		select {
+		case event := <-ao.queue:
+			deliver(ao.observer, event)
+			ao.mu.Lock()
+			ao.release()
+			ao.mu.Unlock()
+		case <-ao.stop:
+			return
+		}
+	}
+}
+
+// Dropped returns how many events the drop policy discarded
+func (ao *AsyncObserver) Dropped() int64 {
+	return ao.dropped.Load()
+}
+
+// Flush waits until every event queued so far has been delivered, or ctx is done
+func (ao *AsyncObserver) Flush(ctx context.Context) error {
+	ao.mu.Lock()
+	if ao.pending == 0 {
+		ao.mu.Unlock()
+		return nil
+	}
+	idle := ao.idle
+	ao.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close flushes the queue and stops the background goroutine. Events observed after
+// Close are delivered inline. If ctx is done first, Close returns its error and the
+// goroutine keeps draining the queue.
+func (ao *AsyncObserver) Close(ctx context.Context) error {
+	ao.mu.Lock()
+	if ao.closed {
+		ao.mu.Unlock()
+		return nil
+	}
+	ao.closed = true
+	ao.mu.Unlock()
+	track(liveAsyncObservers, ao, false)
+
+	if err := ao.Flush(ctx); err != nil {
+		go func() {
+			ao.Flush(context.Background())
+			close(ao.stop)
+		}()
+		return err
+	}
+	close(ao.stop)
+	<-ao.stopped
+	return nil
+}
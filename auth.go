@@ -0,0 +1,50 @@
+package goexceptions
+
+import "fmt"
+
+// ============================================================================
+// AUTHENTICATION AND AUTHORIZATION: Who the caller is, and what it may do
+// ============================================================================
+
+// AuthenticationException is thrown when the caller's identity cannot be established:
+// missing, expired or invalid credentials. It maps to HTTP 401 by default.
+type AuthenticationException struct {
+	Subject string // claimed identity, when known
+	Message string
+}
+
+func (e AuthenticationException) Error() string {
+	if e.Subject == "" {
+		return fmt.Sprintf("AuthenticationException: %s", e.Message)
+	}
+	return fmt.Sprintf("AuthenticationException: %s (Subject: %s)", e.Message, e.Subject)
+}
+
+func (e AuthenticationException) TypeName() string {
+	return "AuthenticationException"
+}
+
+// AuthorizationException is thrown when an authenticated subject is not allowed to
+// perform an action on a resource. It maps to HTTP 403 by default.
+type AuthorizationException struct {
+	Subject  string
+	Resource string
+	Action   string
+	Message  string
+}
+
+func (e AuthorizationException) Error() string {
+	return fmt.Sprintf("AuthorizationException: '%s' may not %s '%s'. %s", e.Subject, e.Action, e.Resource, e.Message)
+}
+
+func (e AuthorizationException) TypeName() string {
+	return "AuthorizationException"
+}
+
+func ThrowAuthentication(subject, message string) {
+	Throw(AuthenticationException{Subject: subject, Message: message})
+}
+
+func ThrowAuthorization(subject, resource, action string) {
+	Throw(AuthorizationException{Subject: subject, Resource: resource, Action: action})
+}
@@ -0,0 +1,91 @@
+package goexceptions
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ============================================================================
+// AWAIT HELPERS: Exception-aware channel receives
+// ============================================================================
+
+// AwaitOrThrow receives from ch, waiting at most timeout. It throws a TimeoutException
+// naming the operation when nothing is delivered in time, and an
+// OperationCanceledException when ch is closed without delivering.
+func AwaitOrThrow[T any](ch <-chan T, timeout time.Duration, name string) T {
+	start := time.Now()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-ch:
+		if ok {
+			return value
+		}
+		Throw(OperationCanceledException{Operation: name, Reason: "channel closed", Message: "no value was delivered"})
+	case <-timer.C:
+		Throw(TimeoutException{Operation: name, Timeout: timeout, Elapsed: time.Since(start), Message: "no value was delivered"})
+	}
+	var zero T
+	return zero
+}
+
+// ReceiveCtx receives from ch until ctx is done. It throws a TimeoutException when the
+// context's deadline passed, an OperationCanceledException when it was canceled, and
+// an OperationCanceledException when ch is closed without delivering.
+func ReceiveCtx[T any](ctx context.Context, ch <-chan T) T {
+	start := time.Now()
+	select {
+	case value, ok := <-ch:
+		if ok {
+			return value
+		}
+		Throw(OperationCanceledException{Reason: "channel closed", Message: "no value was delivered"})
+	case <-ctx.Done():
+		throwContextDone(ctx, "", time.Since(start))
+	}
+	var zero T
+	return zero
+}
+
+// throwContextDone throws the exception matching why ctx is done. The context error
+// is kept as its cause, and the cause given to context.WithCancelCause or
+// WithTimeoutCause, when there is one, becomes its inner exception so the real reason
+// (a client disconnect, a parent timeout) shows in the chain.
+func throwContextDone(ctx context.Context, operation string, elapsed time.Duration) {
+	var exception ExceptionType
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		exception = TimeoutException{Operation: operation, Elapsed: elapsed, Message: ctx.Err().Error()}
+	} else {
+		exception = OperationCanceledException{
+			Operation: operation,
+			Reason:    ctx.Err().Error(),
+			ByUser:    errors.Is(context.Cause(ctx), ErrCanceledByUser),
+		}
+	}
+
+	ex := newException(exception, contextCauseException(ctx))
+	ex.cause = ctx.Err()
+	panic(ex)
+}
+
+// contextCauseException converts the cancellation cause of ctx, or returns nil when
+// the cause is the context error itself
+func contextCauseException(ctx context.Context) *Exception {
+	cause := context.Cause(ctx)
+	if cause == nil || cause == ctx.Err() {
+		return nil
+	}
+
+	var thrown Exception
+	if errors.As(cause, &thrown) {
+		return &thrown
+	}
+	return &Exception{
+		Type:       TranslateError(cause),
+		StackTrace: StackTraceOf(cause),
+		Data:       make(map[string]interface{}),
+		cause:      cause,
+	}
+}
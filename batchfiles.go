@@ -0,0 +1,143 @@
+package goexceptions
+
+// ============================================================================
+// BATCH FILE PROCESSING: Per-file Try with aggregation and resumability
+// ============================================================================
+
+// FileFailure records the exception thrown while processing one file
+type FileFailure struct {
+	Path      string
+	Exception *Exception // always a FileException, wrapping the original as inner exception
+}
+
+// FileProgress is reported after each file
+type FileProgress struct {
+	Path   string
+	Index  int // 0-based position in the batch
+	Total  int
+	Failed bool
+}
+
+// FileBatchResult summarizes a ProcessFiles run
+type FileBatchResult struct {
+	Succeeded []string
+	Failures  []FileFailure
+	Pending   []string // not attempted because processing stopped early
+
+	paths []string
+}
+
+// HasFailures reports whether any file failed
+func (r *FileBatchResult) HasFailures() bool {
+	return len(r.Failures) > 0
+}
+
+// ResumePaths returns the failed and pending paths in their original order, ready to
+// be passed to ProcessFiles again
+func (r *FileBatchResult) ResumePaths() []string {
+	retry := make(map[string]bool, len(r.Failures)+len(r.Pending))
+	for _, failure := range r.Failures {
+		retry[failure.Path] = true
+	}
+	for _, path := range r.Pending {
+		retry[path] = true
+	}
+
+	var paths []string
+	for _, path := range r.paths {
+		if retry[path] {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// Exception returns an AggregateException of every file failure, or nil
+func (r *FileBatchResult) Exception() *Exception {
+	if !r.HasFailures() {
+		return nil
+	}
+	return &Exception{
+		Type: r.aggregate(),
+		Data: make(map[string]interface{}),
+	}
+}
+
+// ThrowIfFailed throws an AggregateException of every file failure, if any
+func (r *FileBatchResult) ThrowIfFailed() {
+	if r.HasFailures() {
+		Throw(r.aggregate())
+	}
+}
+
+func (r *FileBatchResult) aggregate() AggregateException {
+	failures := make([]*Exception, len(r.Failures))
+	for i, failure := range r.Failures {
+		failures[i] = failure.Exception
+	}
+	return AggregateException{Message: "file batch failed", Exceptions: failures}
+}
+
+// ProcessOption configures ProcessFiles
+type ProcessOption func(*processConfig)
+
+type processConfig struct {
+	progress      func(FileProgress)
+	stopOnFailure bool
+}
+
+// WithFileProgress calls progress after each file
+func WithFileProgress(progress func(FileProgress)) ProcessOption {
+	return func(c *processConfig) {
+		c.progress = progress
+	}
+}
+
+// WithStopOnFailure stops at the first failing file; the rest are left Pending
+func WithStopOnFailure() ProcessOption {
+	return func(c *processConfig) {
+		c.stopOnFailure = true
+	}
+}
+
+// ProcessFiles calls fn for every path inside its own Try. Failures never stop the
+// batch unless WithStopOnFailure is given; each one is recorded as a FileException
+// for its path. Use ResumePaths to retry what did not complete.
+func ProcessFiles(paths []string, fn func(path string), opts ...ProcessOption) *FileBatchResult {
+	var config processConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	result := &FileBatchResult{paths: paths}
+	for i, path := range paths {
+		ex := Try(func() { fn(path) }, WithName(path)).settle()
+		if ex == nil {
+			result.Succeeded = append(result.Succeeded, path)
+		} else {
+			result.Failures = append(result.Failures, FileFailure{Path: path, Exception: asFileException(path, ex)})
+		}
+
+		if config.progress != nil {
+			config.progress(FileProgress{Path: path, Index: i, Total: len(paths), Failed: ex != nil})
+		}
+		if ex != nil && config.stopOnFailure {
+			result.Pending = append(result.Pending, paths[i+1:]...)
+			break
+		}
+	}
+	return result
+}
+
+// asFileException keeps FileExceptions for the same path and wraps anything else
+func asFileException(path string, ex *Exception) *Exception {
+	if fileEx, ok := ex.Type.(FileException); ok && fileEx.Filename == path {
+		return ex
+	}
+	return &Exception{
+		Type:   FileException{Filename: path, Message: "processing failed"},
+		Origin: ex.Origin,
+		Data:   make(map[string]interface{}),
+		Inner:  ex,
+	}
+}
@@ -0,0 +1,65 @@
+package goexceptions
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// ============================================================================
+// BLOCKING HANDLER GUARD: Flag slow handlers on error paths (debug mode)
+// ============================================================================
+
+// BlockingHandlerException is reported to observers, with EventDiagnostic, when a
+// handler is still running after the blocking threshold. The exception being
+// handled is its inner exception.
+type BlockingHandlerException struct {
+	Handler   string
+	Threshold time.Duration
+}
+
+func (e BlockingHandlerException) Error() string {
+	return fmt.Sprintf("BlockingHandlerException: handler '%s' blocked for more than %v", e.Handler, e.Threshold)
+}
+
+func (e BlockingHandlerException) TypeName() string {
+	return "BlockingHandlerException"
+}
+
+const defaultBlockingThreshold = 100 * time.Millisecond
+
+// blockingThreshold holds the threshold set by the user: 0 for the default, negative
+// when disabled
+var blockingThreshold atomic.Int64
+
+// SetBlockingHandlerThreshold sets how long a handler may run in debug mode before
+// it is reported as blocking (100ms by default). Handlers on error paths that make
+// synchronous network calls delay every failing request. Zero disables the guard.
+func SetBlockingHandlerThreshold(d time.Duration) {
+	if d <= 0 {
+		d = -1
+	}
+	blockingThreshold.Store(int64(d))
+}
+
+// watchBlocking reports the handler if it is still running after the threshold.
+// The returned function must be called when the handler returns.
+func (tr *TryResult) watchBlocking(by handlerRef) (done func()) {
+	threshold := time.Duration(blockingThreshold.Load())
+	if threshold == 0 {
+		threshold = defaultBlockingThreshold
+	}
+	if threshold < 0 || !DebugMode() {
+		return func() {}
+	}
+
+	exception := tr.exception
+	timer := time.AfterFunc(threshold, func() {
+		tr.emit(EventDiagnostic, &Exception{
+			Type:  BlockingHandlerException{Handler: by.String(), Threshold: threshold},
+			Data:  make(map[string]interface{}),
+			Inner: exception,
+		}, "")
+	})
+	return func() { timer.Stop() }
+}
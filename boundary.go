@@ -0,0 +1,90 @@
+package goexceptions
+
+// ============================================================================
+// PACKAGE BOUNDARIES: Exceptions inside, idiomatic errors outside
+// ============================================================================
+
+// ExceptionError is an exception converted to a plain error, for libraries that use
+// exceptions internally but return errors from their public API. It is a snapshot:
+// it stays intact when the exception is changed after the Try that raised it
+// completed. Data is redacted (see RedactKeys).
+//
+// Unwrap returns the exception, so errors.Is and errors.As keep working on the
+// exception type, its causes and its inner exceptions.
+type ExceptionError struct {
+	TypeName   string
+	Message    string
+	Data       map[string]interface{}
+	StackTrace []string
+	exception  Exception
+}
+
+func (e *ExceptionError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the exception the error was converted from
+func (e *ExceptionError) Unwrap() error {
+	return e.exception
+}
+
+// Exception returns a copy of the exception the error was converted from
+func (e *ExceptionError) Exception() Exception {
+	return e.exception
+}
+
+// ToError converts the exception to an ExceptionError, or returns nil for a nil
+// exception
+func (e *Exception) ToError() error {
+	if e == nil {
+		return nil
+	}
+	snapshot := *e
+	if e.Data != nil {
+		snapshot.Data = make(map[string]interface{}, len(e.Data))
+		for key, value := range e.Data {
+			snapshot.Data[key] = value
+		}
+	}
+	snapshot.owner = nil
+
+	return &ExceptionError{
+		TypeName:   e.TypeName(),
+		Message:    e.Error(),
+		Data:       e.RedactedData(),
+		StackTrace: append([]string(nil), e.StackTrace...),
+		exception:  snapshot,
+	}
+}
+
+// Err returns the captured exception as an ExceptionError, whether or not it was
+// handled, or nil when the block succeeded. Unlike AsError it does not end the
+// chain. errors.As extracts the exception type from the result:
+//
+//	err := Try(sync).Any(logIt).Err()
+//	var timeout TimeoutException
+//	if errors.As(err, &timeout) { ... }
+func (tr *TryResult) Err() error {
+	if tr == nil || tr.exception == nil {
+		return nil
+	}
+	return tr.exception.ToError()
+}
+
+// AsError ends the chain like Rethrow, but returns the exception as an error instead
+// of throwing it: an ExceptionError if the exception was not handled, nil otherwise.
+//
+//	func (c *Client) Fetch(id string) (order Order, err error) {
+//	    return order, Try(func() { order = c.fetch(id) }).Handle(notFound).AsError()
+//	}
+func (tr *TryResult) AsError() error {
+	if tr == nil {
+		return nil
+	}
+	var err error
+	if tr.exception != nil && !tr.handled {
+		err = tr.exception.ToError()
+	}
+	tr.complete()
+	return err
+}
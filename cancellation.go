@@ -0,0 +1,161 @@
+package goexceptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// TIMEOUTS AND CANCELLATION: Exceptions for operations that did not finish
+// ============================================================================
+
+// TimeoutException is thrown when an operation did not complete within its deadline
+type TimeoutException struct {
+	Operation string
+	Timeout   time.Duration // the deadline, 0 when it came from a context
+	Elapsed   time.Duration // time spent waiting
+	Message   string
+}
+
+func (e TimeoutException) Error() string {
+	return fmt.Sprintf("TimeoutException: operation '%s' timed out after %v. %s", e.Operation, e.Elapsed, e.Message)
+}
+
+func (e TimeoutException) TypeName() string {
+	return "TimeoutException"
+}
+
+// OperationCanceledException is thrown when an operation was abandoned before it
+// completed, as opposed to failing. Context cancellations translate to it, never to
+// InvalidOperationException, so handlers and metrics can tell the two apart.
+type OperationCanceledException struct {
+	Operation string
+	Reason    string
+	ByUser    bool // canceled on a user's request, see ErrCanceledByUser
+	Message   string
+}
+
+func (e OperationCanceledException) Error() string {
+	return fmt.Sprintf("OperationCanceledException: operation '%s' was canceled (%s). %s", e.Operation, e.Reason, e.Message)
+}
+
+func (e OperationCanceledException) TypeName() string {
+	return "OperationCanceledException"
+}
+
+// ErrCanceledByUser is the cancellation cause to use when a user asked to stop the
+// operation. Cancellations caused by it have ByUser set:
+//
+//	ctx, cancel := context.WithCancelCause(ctx)
+//	onAbortClicked(func() { cancel(ErrCanceledByUser) })
+var ErrCanceledByUser = errors.New("canceled by user")
+
+// translateContextError maps context errors and cancellation causes, so they never
+// become InvalidOperationException
+func translateContextError(err error) (ExceptionType, bool) {
+	switch {
+	case errors.Is(err, ErrCanceledByUser):
+		return OperationCanceledException{Reason: err.Error(), ByUser: true}, true
+	case errors.Is(err, context.Canceled):
+		return OperationCanceledException{Reason: err.Error()}, true
+	case errors.Is(err, context.DeadlineExceeded):
+		return TimeoutException{Message: err.Error()}, true
+	}
+	return nil, false
+}
+
+// TryCtx runs block like Try with WithContext(ctx), passing it the context. A block
+// that observes cancellation, with ThrowIfDone, ReceiveCtx or ThrowIfError(ctx.Err()),
+// throws an OperationCanceledException, or a TimeoutException when the deadline
+// passed, and handlers get the context back from Exception.Context:
+//
+//	TryCtx(r.Context(), func(ctx context.Context) {
+//	    for _, item := range items {
+//	        ThrowIfDone(ctx)
+//	        process(ctx, item)
+//	    }
+//	}).Handle(
+//	    Handler[OperationCanceledException](func(ex OperationCanceledException, full Exception) {
+//	        log.Printf("stopped: %v", context.Cause(full.Context()))
+//	    }),
+//	)
+func TryCtx(ctx context.Context, block func(ctx context.Context), opts ...TryOption) *TryResult {
+	opts = append(opts[:len(opts):len(opts)], WithContext(ctx))
+	return Try(func() {
+		block(ctx)
+	}, opts...)
+}
+
+// TryWithTimeout runs block like Try but waits at most d for it. A block still running
+// at the deadline is abandoned on its goroutine and the result holds a
+// TimeoutException with the elapsed time and the name set with WithName as operation;
+// a later panic of the abandoned block is reported to observers. Blocks that can stop
+// early should rather use TryCtx with a context.WithTimeout.
+func TryWithTimeout(d time.Duration, block func(), opts ...TryOption) *TryResult {
+	operation := newTryResult(opts).config.name
+	done := make(chan any, 1)
+	var timedOut bool
+
+	tr := Try(func() {
+		go func() {
+			defer func() {
+				r := recover()
+				if r != nil && !isPassthrough(r) {
+					r = exceptionFromPanic(r)
+				}
+				done <- r
+			}()
+			block()
+		}()
+
+		start := time.Now()
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case r := <-done:
+			if ex, ok := r.(*Exception); ok {
+				panic(*ex)
+			}
+			if r != nil {
+				panic(r)
+			}
+		case <-timer.C:
+			timedOut = true
+			Throw(TimeoutException{
+				Operation: operation,
+				Timeout:   d,
+				Elapsed:   time.Since(start),
+				Message:   "block abandoned, still running in background",
+			})
+		}
+	}, opts...)
+
+	if timedOut {
+		abandoned.add()
+		go tr.watchAbandonedBlock(done)
+	}
+	return tr
+}
+
+func (tr *TryResult) watchAbandonedBlock(done <-chan any) {
+	defer abandoned.done()
+	switch r := (<-done).(type) {
+	case nil:
+	case *Exception:
+		tr.emit(EventUnhandled, r, "")
+	default:
+		tr.emit(EventUnhandled, exceptionFromPanic(r), "")
+	}
+}
+
+// ThrowIfDone throws the exception matching why ctx is done, if it is: a
+// TimeoutException when its deadline passed, an OperationCanceledException otherwise,
+// with the cancellation cause as inner exception (see ReceiveCtx)
+func ThrowIfDone(ctx context.Context) {
+	if ctx.Err() != nil {
+		throwContextDone(ctx, "", 0)
+	}
+}
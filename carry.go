@@ -0,0 +1,47 @@
+package goexceptions
+
+import "context"
+
+// ============================================================================
+// CONTEXT CARRY: Hand exceptions to a later stage through a context
+// ============================================================================
+
+type attachedExceptionKey struct{}
+
+// AttachToContext returns a context carrying the exception captured by tr, for a later
+// stage or a parallel branch to decide how to respond. Attaching hands the exception
+// off: an unhandled exception is marked handled by "AttachToContext", so ending the
+// chain does not report it. Without an exception, ctx is returned as is.
+//
+//	tr := Try(func() { authenticate(r) })
+//	ctx := AttachToContext(r.Context(), tr)
+//	tr.End()
+//	...
+//	if ex := ExceptionFromContext(ctx); ex != nil {
+//	    respondWith(w, ex)
+//	}
+func AttachToContext(ctx context.Context, tr *TryResult) context.Context {
+	if tr == nil || tr.settle() == nil {
+		return ctx
+	}
+	if !tr.handled {
+		tr.markHandled(handlerRef{label: "AttachToContext"})
+	}
+	attached := *tr.exception
+	attached.owner = nil
+	return context.WithValue(ctx, attachedExceptionKey{}, attached)
+}
+
+// ExceptionFromContext returns a copy of the exception attached to ctx with
+// AttachToContext, or nil. Exception.Rethrow throws it again with its original stack
+// trace and origin.
+func ExceptionFromContext(ctx context.Context) *Exception {
+	if ctx == nil {
+		return nil
+	}
+	attached, ok := ctx.Value(attachedExceptionKey{}).(Exception)
+	if !ok {
+		return nil
+	}
+	return &attached
+}
@@ -0,0 +1,257 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// CIRCUIT BREAKER: Stop calling a failing dependency for a while
+// ============================================================================
+
+// CircuitOpenException is thrown instead of running a block while its circuit
+// breaker is open
+type CircuitOpenException struct {
+	Circuit    string
+	RetryAfter time.Duration // time left before the breaker lets a trial call through
+	Message    string
+}
+
+func (e CircuitOpenException) Error() string {
+	return fmt.Sprintf("CircuitOpenException: circuit '%s' is open, retry after %v. %s", e.Circuit, e.RetryAfter, e.Message)
+}
+
+func (e CircuitOpenException) TypeName() string {
+	return "CircuitOpenException"
+}
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+const (
+	// CircuitClosed runs every block
+	CircuitClosed CircuitState = iota
+	// CircuitOpen runs no block until the cooldown has passed
+	CircuitOpen
+	// CircuitHalfOpen runs one trial block, whose outcome closes or reopens the breaker
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitOption configures NewCircuitBreaker
+type CircuitOption func(*circuitConfig)
+
+type circuitConfig struct {
+	threshold int
+	window    time.Duration
+	cooldown  time.Duration
+	tripIf    []func(ex *Exception) bool
+}
+
+// WithTripThreshold opens the breaker after n tripping exceptions within window (5
+// within a minute by default)
+func WithTripThreshold(n int, window time.Duration) CircuitOption {
+	return func(c *circuitConfig) {
+		c.threshold = n
+		c.window = window
+	}
+}
+
+// WithCooldown sets how long the breaker stays open before a trial call (30s by
+// default)
+func WithCooldown(d time.Duration) CircuitOption {
+	return func(c *circuitConfig) {
+		c.cooldown = d
+	}
+}
+
+// TripOn counts exceptions of type T, or of types implementing or embedding it,
+// towards opening the breaker. It can be given several times.
+func TripOn[T ExceptionType]() CircuitOption {
+	return WithTripIf(func(ex *Exception) bool {
+		return isTypeMatch[T](reflect.TypeOf(ex.Type))
+	})
+}
+
+// WithTripIf counts the exceptions accepted by tripIf towards opening the breaker. It
+// can be combined with TripOn; an exception accepted by any of them counts.
+func WithTripIf(tripIf func(ex *Exception) bool) CircuitOption {
+	return func(c *circuitConfig) {
+		c.tripIf = append(c.tripIf, tripIf)
+	}
+}
+
+// CircuitBreaker stops running blocks calling a failing dependency. After the
+// threshold of tripping exceptions within the window it opens, and blocks throw a
+// CircuitOpenException without running. Once the cooldown has passed it lets one
+// trial block through: a tripping exception opens it again, any other outcome
+// closes it.
+//
+// Without TripOn or WithTripIf, the exceptions counted are the retryable ones (see
+// IsRetryable). Other exceptions propagate without affecting the breaker, so a
+// validation failure never opens the circuit of a healthy dependency.
+type CircuitBreaker struct {
+	name   string
+	config circuitConfig
+
+	mutex    sync.Mutex
+	state    CircuitState
+	failures []time.Time // tripping exceptions within the window, oldest first
+	openedAt time.Time
+}
+
+// NewCircuitBreaker returns a closed breaker, named in its CircuitOpenExceptions:
+//
+//	payments := NewCircuitBreaker("payments", TripOn[NetworkException](), TripOn[TimeoutException]())
+//
+//	payments.Try(func() {
+//	    charge(order)
+//	}).Handle(
+//	    Handler[CircuitOpenException](func(ex CircuitOpenException, full Exception) { queueForLater(order) }),
+//	)
+func NewCircuitBreaker(name string, opts ...CircuitOption) *CircuitBreaker {
+	config := circuitConfig{
+		threshold: 5,
+		window:    time.Minute,
+		cooldown:  30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.threshold < 1 {
+		config.threshold = 1
+	}
+	if len(config.tripIf) == 0 {
+		config.tripIf = []func(ex *Exception) bool{IsRetryable}
+	}
+	return &CircuitBreaker{name: name, config: config}
+}
+
+// State returns the state of the breaker. An open breaker whose cooldown has passed
+// reports CircuitHalfOpen.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	if cb.state == CircuitOpen && time.Since(cb.openedAt) >= cb.config.cooldown {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+// Try runs block like Try through the breaker
+func (cb *CircuitBreaker) Try(block func(), opts ...TryOption) *TryResult {
+	return Try(func() {
+		cb.Run(block)
+	}, opts...)
+}
+
+// Run runs block through the breaker, throwing a CircuitOpenException instead when
+// the breaker is open. Exceptions of the block propagate.
+func (cb *CircuitBreaker) Run(block func()) {
+	trial, retryAfter, open := cb.admit()
+	if open {
+		Throw(CircuitOpenException{
+			Circuit:    cb.name,
+			RetryAfter: retryAfter,
+			Message:    "calls are short-circuited while the dependency recovers",
+		})
+	}
+
+	completed := false
+	defer func() {
+		if completed {
+			return
+		}
+		r := recover()
+		if r == nil || isPassthrough(r) {
+			cb.record(nil, trial)
+			if r != nil {
+				panic(r)
+			}
+			return
+		}
+		ex := exceptionFromPanic(r)
+		cb.record(ex, trial)
+		panic(*ex)
+	}()
+	block()
+	completed = true
+	cb.record(nil, trial)
+}
+
+// admit decides whether a block may run, and whether it runs as the trial of a
+// half-open breaker
+func (cb *CircuitBreaker) admit() (trial bool, retryAfter time.Duration, open bool) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	switch cb.state {
+	case CircuitClosed:
+		return false, 0, false
+	case CircuitOpen:
+		if elapsed := time.Since(cb.openedAt); elapsed < cb.config.cooldown {
+			return false, cb.config.cooldown - elapsed, true
+		}
+		cb.state = CircuitHalfOpen
+		return true, 0, false
+	default:
+		// a trial is running
+		return false, 0, true
+	}
+}
+
+// record updates the breaker with the outcome of a block, ex being nil on success
+func (cb *CircuitBreaker) record(ex *Exception, trial bool) {
+	trips := ex != nil && cb.trips(ex)
+	now := time.Now()
+
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	if trial {
+		if trips {
+			cb.state, cb.openedAt = CircuitOpen, now
+		} else {
+			cb.state, cb.failures = CircuitClosed, nil
+		}
+		return
+	}
+	if !trips || cb.state != CircuitClosed {
+		return
+	}
+
+	cutoff := now.Add(-cb.config.window)
+	kept := cb.failures[:0]
+	for _, at := range cb.failures {
+		if at.After(cutoff) {
+			kept = append(kept, at)
+		}
+	}
+	cb.failures = append(kept, now)
+	if len(cb.failures) >= cb.config.threshold {
+		cb.state, cb.openedAt, cb.failures = CircuitOpen, now, nil
+	}
+}
+
+func (cb *CircuitBreaker) trips(ex *Exception) bool {
+	for _, tripIf := range cb.config.tripIf {
+		if tripIf(ex) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package goexceptions
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// ============================================================================
+// CLOUDEVENTS: Failure events for event buses
+// ============================================================================
+
+// CloudEventTypePrefix is prepended to the code or TypeName of an exception to form
+// the type of its CloudEvent
+var CloudEventTypePrefix = "goexceptions."
+
+// CloudEvent is a CloudEvents 1.0 event in the JSON structured format
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// CloudEventData is the data of an exception's CloudEvent
+type CloudEventData struct {
+	Type        string                 `json:"type"`
+	Message     string                 `json:"message"`
+	Code        string                 `json:"code,omitempty"`
+	Fingerprint string                 `json:"fingerprint"`
+	Origin      string                 `json:"origin,omitempty"`
+	Data        map[string]interface{} `json:"data,omitempty"` // redacted, see RedactedData
+	Inner       *CloudEventData        `json:"inner,omitempty"`
+}
+
+// ToCloudEvent converts ex to a CloudEvent from source, a URI reference identifying
+// the service. Its type is CloudEventTypePrefix followed by the Code field of the
+// exception, or its TypeName, its subject the name of the Try that caught it (see
+// WithName):
+//
+//	event, err := ToCloudEvent(&full, "/services/checkout")
+//	payload, err := json.Marshal(event)
+func ToCloudEvent(ex *Exception, source string) (CloudEvent, error) {
+	data, err := json.Marshal(cloudEventData(ex, 0))
+	if err != nil {
+		return CloudEvent{}, err
+	}
+
+	code := codeOf(ex.Type)
+	if code == "" {
+		code = ex.TypeName()
+	}
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              newCloudEventID(),
+		Source:          source,
+		Type:            CloudEventTypePrefix + code,
+		Subject:         ex.owner.Name(),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}, nil
+}
+
+// cloudEventData describes ex and its inner exceptions, down to maxRenderDepth
+func cloudEventData(ex *Exception, depth int) *CloudEventData {
+	if ex == nil || depth >= maxRenderDepth {
+		return nil
+	}
+	return &CloudEventData{
+		Type:        ex.TypeName(),
+		Message:     ex.Error(),
+		Code:        codeOf(ex.Type),
+		Fingerprint: ex.Fingerprint(),
+		Origin:      ex.Origin,
+		Data:        ex.RedactedData(),
+		Inner:       cloudEventData(ex.Inner, depth+1),
+	}
+}
+
+func newCloudEventID() string {
+	var id [16]byte
+	rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
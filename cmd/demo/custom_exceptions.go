@@ -39,20 +39,6 @@ func (e BusinessRuleException) TypeName() string {
 	return "BusinessRuleException"
 }
 
-// Custom exception for authentication
-type AuthenticationException struct {
-	Username string
-	Reason   string
-}
-
-func (e AuthenticationException) Error() string {
-	return fmt.Sprintf("AuthenticationException: %s (User: %s)", e.Reason, e.Username)
-}
-
-func (e AuthenticationException) TypeName() string {
-	return "AuthenticationException"
-}
-
 // ============================================================================
 // HELPER FUNCTIONS FOR CUSTOM EXCEPTIONS
 // ============================================================================
@@ -73,13 +59,6 @@ func ThrowBusinessRule(rule string, value interface{}, message string) {
 	})
 }
 
-func ThrowAuthenticationError(username, reason string) {
-	Throw(AuthenticationException{
-		Username: username,
-		Reason:   reason,
-	})
-}
-
 // ============================================================================
 // EXAMPLES USING CUSTOM EXCEPTIONS
 // ============================================================================
@@ -113,14 +92,15 @@ func main() {
 		}),
 	)
 
-	// Example 3: Authentication exception
+	// Example 3: Built-in authentication exception
 	fmt.Println("\n3. Authentication Exception:")
 	Try(func() {
-		ThrowAuthenticationError("john.doe", "Invalid password")
+		ThrowAuthentication("john.doe", "Invalid password")
 	}).Handle(
 		Handler[AuthenticationException](func(ex AuthenticationException, full Exception) {
-			fmt.Printf("   Auth failed for user: %s\n", ex.Username)
-			fmt.Printf("   Reason: %s\n", ex.Reason)
+			fmt.Printf("   Auth failed for user: %s\n", ex.Subject)
+			fmt.Printf("   Reason: %s\n", ex.Message)
+			fmt.Printf("   HTTP status: %d\n", HTTPStatusFor(&full))
 		}),
 	)
 
@@ -134,7 +114,7 @@ func main() {
 			case 1:
 				ThrowBusinessRule("MaxLoginAttempts", 5, "Too many login attempts")
 			case 2:
-				ThrowAuthenticationError("admin", "Account locked")
+				ThrowAuthentication("admin", "Account locked")
 			}
 		}).Handle(
 			Handler[DatabaseException](func(ex DatabaseException, full Exception) {
@@ -144,7 +124,7 @@ func main() {
 				fmt.Printf("   Rule Error: %s = %v\n", ex.Rule, ex.Value)
 			}),
 			Handler[AuthenticationException](func(ex AuthenticationException, full Exception) {
-				fmt.Printf("   Auth Error: %s (%s)\n", ex.Username, ex.Reason)
+				fmt.Printf("   Auth Error: %s (%s)\n", ex.Subject, ex.Message)
 			}),
 			HandlerAny(func(ex Exception) {
 				fmt.Printf("   Unexpected: %s\n", ex.Error())
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// Filter selects exceptions. Empty fields select everything.
+type Filter struct {
+	Type        string // TypeName of the exception or of one in its chain
+	Fingerprint string // fingerprint of the outermost exception
+}
+
+// Match reports whether ex is selected
+func (f Filter) Match(ex *Exception) bool {
+	if f.Fingerprint != "" && ex.Fingerprint() != f.Fingerprint {
+		return false
+	}
+	if f.Type == "" {
+		return true
+	}
+	found := false
+	walk(ex, func(current *Exception, depth int) {
+		found = found || current.TypeName() == f.Type
+	})
+	return found
+}
+
+// walk visits ex, its inner exceptions and the exceptions of aggregates, depth
+// first, down to maxDepth
+func walk(ex *Exception, visit func(ex *Exception, depth int)) {
+	var step func(ex *Exception, depth int)
+	step = func(ex *Exception, depth int) {
+		if ex == nil || depth >= maxDepth {
+			return
+		}
+		visit(ex, depth)
+		for _, child := range children(ex) {
+			step(child, depth+1)
+		}
+	}
+	step(ex, 0)
+}
+
+// maxDepth bounds chains read from untrusted files
+const maxDepth = 32
+
+// children returns the exceptions of an aggregate, then the inner exception
+func children(ex *Exception) []*Exception {
+	var result []*Exception
+	if aggregate, ok := ex.Type.(AggregateException); ok {
+		result = append(result, aggregate.Exceptions...)
+	}
+	if ex.Inner != nil {
+		result = append(result, ex.Inner)
+	}
+	return result
+}
+
+// Show pretty-prints each exception with its chain
+func Show(w io.Writer, exceptions []*Exception, stacks bool) {
+	for i, ex := range exceptions {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "#%d %s %s\n", i+1, ex.TypeName(), ex.Fingerprint())
+		walk(ex, func(current *Exception, depth int) {
+			indent := strings.Repeat("    ", depth)
+			prefix := ""
+			if depth > 0 {
+				prefix = "└─ "
+			}
+			fmt.Fprintf(w, "%s%s%s\n", indent, prefix, current.Error())
+			if depth > 0 {
+				indent += "   "
+			}
+			if current.Origin != "" {
+				fmt.Fprintf(w, "%s  at %s\n", indent, current.Origin)
+			}
+			keys := make([]string, 0, len(current.Data))
+			for key := range current.Data {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				fmt.Fprintf(w, "%s  %s = %v\n", indent, key, current.Data[key])
+			}
+			if stacks {
+				for _, frame := range current.StackTrace {
+					fmt.Fprintf(w, "%s    %s\n", indent, frame)
+				}
+			}
+		})
+	}
+}
+
+// Graph writes the chains as a Graphviz digraph of exception types, nodes and
+// edges labeled with how often they occur
+func Graph(w io.Writer, exceptions []*Exception) {
+	nodes := make(map[string]int)
+	edges := make(map[[2]string]int)
+	for _, ex := range exceptions {
+		walk(ex, func(current *Exception, depth int) {
+			nodes[current.TypeName()]++
+			for _, child := range children(current) {
+				edges[[2]string{current.TypeName(), child.TypeName()}]++
+			}
+		})
+	}
+
+	fmt.Fprintln(w, "digraph exceptions {")
+	for _, name := range sortedKeys(nodes) {
+		fmt.Fprintf(w, "\t%q [label=%q];\n", name, fmt.Sprintf("%s (%d)", name, nodes[name]))
+	}
+	edgeKeys := make([][2]string, 0, len(edges))
+	for edge := range edges {
+		edgeKeys = append(edgeKeys, edge)
+	}
+	sort.Slice(edgeKeys, func(i, j int) bool {
+		if edgeKeys[i][0] != edgeKeys[j][0] {
+			return edgeKeys[i][0] < edgeKeys[j][0]
+		}
+		return edgeKeys[i][1] < edgeKeys[j][1]
+	})
+	for _, edge := range edgeKeys {
+		fmt.Fprintf(w, "\t%q -> %q [label=\"%d\"];\n", edge[0], edge[1], edges[edge])
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// Tally writes the number of exceptions per type and fingerprint, most frequent
+// first
+func Tally(w io.Writer, exceptions []*Exception) {
+	type group struct {
+		typeName, fingerprint, origin string
+		count                         int
+	}
+	groups := make(map[string]*group)
+	for _, ex := range exceptions {
+		fingerprint := ex.Fingerprint()
+		g, exists := groups[fingerprint]
+		if !exists {
+			g = &group{typeName: ex.TypeName(), fingerprint: fingerprint, origin: ex.Origin}
+			groups[fingerprint] = g
+		}
+		g.count++
+	}
+
+	sorted := make([]*group, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].count != sorted[j].count {
+			return sorted[i].count > sorted[j].count
+		}
+		return sorted[i].fingerprint < sorted[j].fingerprint
+	})
+
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "COUNT\tTYPE\tFINGERPRINT\tORIGIN")
+	for _, g := range sorted {
+		fmt.Fprintf(table, "%d\t%s\t%s\t%s\n", g.count, g.typeName, g.fingerprint, g.origin)
+	}
+	fmt.Fprintf(table, "%d\ttotal\t\t\n", len(exceptions))
+	table.Flush()
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
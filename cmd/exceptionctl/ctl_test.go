@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+const records = `{"type":"TimeoutException","message":"TimeoutException: load timed out","fields":{"Operation":"load","Message":"gave up"},"origin":"orders.go:12","inner":{"type":"NetworkException","message":"NetworkException: refused","fields":{"URL":"db:5432","Message":"refused"},"origin":"client.go:40"}}
+{"type":"TimeoutException","message":"TimeoutException: load timed out","origin":"orders.go:12","data":{"order":"42"}}
+[{"type":"LedgerException","message":"ledger closed","code":"LEDGER_CLOSED","origin":"ledger.go:7"}]
+{"queue":"payments","attempts":3,"exception":{"type":"NetworkException","message":"NetworkException: reset","origin":"client.go:40"}}
+{"specversion":"1.0","type":"com.example.exception","data":{"type":"TimeoutException","message":"TimeoutException: sync timed out","origin":"sync.go:3"}}
+{"queue":"payments","body":"no exception here"}
+`
+
+func load(t *testing.T) []*Exception {
+	t.Helper()
+	exceptions, skipped, err := Load(strings.NewReader(records))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exceptions) != 5 || skipped != 1 {
+		t.Fatalf("loaded %d exceptions, skipped %d, want 5 and 1", len(exceptions), skipped)
+	}
+	return exceptions
+}
+
+func TestLoad(t *testing.T) {
+	exceptions := load(t)
+
+	timeout, ok := exceptions[0].Type.(TimeoutException)
+	if !ok || timeout.Operation != "load" {
+		t.Errorf("first exception type = %#v, want TimeoutException for load", exceptions[0].Type)
+	}
+	if exceptions[0].Inner == nil || exceptions[0].Inner.TypeName() != "NetworkException" {
+		t.Errorf("inner exception of the first record was not decoded")
+	}
+	if _, ok := exceptions[2].Type.(RemoteException); !ok || exceptions[2].TypeName() != "LedgerException" {
+		t.Errorf("unknown type decoded as %#v, want RemoteException named LedgerException", exceptions[2].Type)
+	}
+	if exceptions[3].Origin != "client.go:40" {
+		t.Errorf("dead-letter record origin = %q", exceptions[3].Origin)
+	}
+	if exceptions[4].Origin != "sync.go:3" {
+		t.Errorf("CloudEvent origin = %q", exceptions[4].Origin)
+	}
+
+	if _, _, err := Load(strings.NewReader(`{"type":`)); err == nil {
+		t.Error("truncated input loaded without error")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	exceptions := load(t)
+
+	count := func(filter Filter) int {
+		n := 0
+		for _, ex := range exceptions {
+			if filter.Match(ex) {
+				n++
+			}
+		}
+		return n
+	}
+	if n := count(Filter{Type: "NetworkException"}); n != 2 {
+		t.Errorf("NetworkException in the chain of %d exceptions, want 2", n)
+	}
+	if n := count(Filter{Fingerprint: exceptions[0].Fingerprint()}); n != 2 {
+		t.Errorf("fingerprint matched %d exceptions, want 2", n)
+	}
+	if n := count(Filter{Type: "NetworkException", Fingerprint: exceptions[0].Fingerprint()}); n != 1 {
+		t.Errorf("type and fingerprint matched %d exceptions, want 1", n)
+	}
+}
+
+func TestCommands(t *testing.T) {
+	exceptions := load(t)
+
+	var out bytes.Buffer
+	Show(&out, exceptions[:2], false)
+	for _, want := range []string{"#1 TimeoutException", "└─ NetworkException: refused (URL: db:5432)", "at client.go:40", "order = 42"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("show output lacks %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	Graph(&out, exceptions)
+	for _, want := range []string{`"TimeoutException" [label="TimeoutException (3)"]`, `"TimeoutException" -> "NetworkException" [label="1"]`} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("graph output lacks %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	Tally(&out, exceptions)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("stats has %d lines, want header, 4 groups and total:\n%s", len(lines), out.String())
+	}
+	if fields := strings.Fields(lines[1]); fields[0] != "2" || fields[1] != "TimeoutException" || fields[3] != "orders.go:12" {
+		t.Errorf("most frequent group = %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[5], "5") {
+		t.Errorf("total line = %q", lines[5])
+	}
+}
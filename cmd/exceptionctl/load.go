@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// envelopeKeys are the keys records carrying an exception keep it under, in the
+// order they are tried: dead-letter records, log lines, CloudEvents
+var envelopeKeys = []string{"exception", "error", "data"}
+
+// Load reads the exceptions of r: JSON documents one after the other, such as JSON
+// lines, arrays of them, and records carrying an exception under one of the
+// envelopeKeys. It returns how many records held no exception.
+func Load(r io.Reader) ([]*Exception, int, error) {
+	var exceptions []*Exception
+	skipped := 0
+	decoder := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return exceptions, skipped, nil
+		}
+		if err != nil {
+			return exceptions, skipped, err
+		}
+		found, err := collect(raw)
+		if err != nil {
+			return exceptions, skipped, err
+		}
+		if len(found) == 0 {
+			skipped++
+		}
+		exceptions = append(exceptions, found...)
+	}
+}
+
+func collect(raw json.RawMessage) ([]*Exception, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	switch raw[0] {
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+		var exceptions []*Exception
+		for _, item := range items {
+			found, err := collect(item)
+			if err != nil {
+				return nil, err
+			}
+			exceptions = append(exceptions, found...)
+		}
+		return exceptions, nil
+	case '{':
+		var record map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &record); err != nil {
+			return nil, err
+		}
+		if isException(record) {
+			ex := new(Exception)
+			if err := json.Unmarshal(raw, ex); err != nil {
+				return nil, err
+			}
+			return []*Exception{ex}, nil
+		}
+		for _, key := range envelopeKeys {
+			if value, ok := record[key]; ok {
+				return collect(value)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// isException tells an exception from an envelope: CloudEvents have a type but no
+// message
+func isException(record map[string]json.RawMessage) bool {
+	_, hasType := record["type"]
+	_, hasMessage := record["message"]
+	return hasType && hasMessage
+}
@@ -0,0 +1,93 @@
+// Command exceptionctl inspects exceptions serialized as JSON (see
+// Exception.MarshalJSON) from the terminal. It reads JSON documents, arrays and JSON
+// lines, as well as dead-letter records and CloudEvents carrying an exception under
+// an "exception", "error" or "data" key, from the given files or standard input:
+//
+//	exceptionctl show -type TimeoutException failures.jsonl    chains, with -stacks for frames
+//	exceptionctl graph dead-letters.json | dot -Tsvg > out.svg  type graph of the chains
+//	exceptionctl stats -fingerprint 9f3c... failures.jsonl     counts per fingerprint
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+const usage = `usage: exceptionctl show|graph|stats [flags] [files]
+
+flags:
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	var filter Filter
+	flags.StringVar(&filter.Type, "type", "", "only exceptions with this type in their chain")
+	flags.StringVar(&filter.Fingerprint, "fingerprint", "", "only exceptions with this fingerprint")
+	stacks := flags.Bool("stacks", false, "show stack frames (show)")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(os.Args[2:])
+
+	var run func(w io.Writer, exceptions []*Exception)
+	switch command {
+	case "show":
+		run = func(w io.Writer, exceptions []*Exception) { Show(w, exceptions, *stacks) }
+	case "graph":
+		run = Graph
+	case "stats":
+		run = Tally
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	exceptions, err := loadAll(flags.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "exceptionctl:", err)
+		os.Exit(1)
+	}
+	selected := exceptions[:0]
+	for _, ex := range exceptions {
+		if filter.Match(ex) {
+			selected = append(selected, ex)
+		}
+	}
+	run(os.Stdout, selected)
+}
+
+func loadAll(paths []string) ([]*Exception, error) {
+	if len(paths) == 0 {
+		exceptions, _, err := Load(os.Stdin)
+		return exceptions, err
+	}
+
+	var all []*Exception
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		exceptions, skipped, err := Load(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if skipped > 0 {
+			fmt.Fprintf(os.Stderr, "exceptionctl: %s: %d records without an exception\n", path, skipped)
+		}
+		all = append(all, exceptions...)
+	}
+	return all, nil
+}
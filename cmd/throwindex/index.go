@@ -0,0 +1,309 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const modulePath = "github.com/bencz/go-exceptions"
+
+// ThrowSite is one call to a throw function
+type ThrowSite struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Function string `json:"function"`          // enclosing function, Type.Method for methods
+	Call     string `json:"call"`              // throw function called, such as ThrowIf
+	Type     string `json:"type,omitempty"`    // exception type, empty when only known at run time
+	Message  string `json:"message,omitempty"` // literal message, or the format of a Sprintf
+
+	// Deprecated is set when a Deprecate call of the indexed code retires Type, whose
+	// replacements are then listed
+	Deprecated   bool     `json:"deprecated,omitempty"`
+	Replacements []string `json:"replacements,omitempty"`
+}
+
+// helper describes a ThrowX helper: the exception it throws and which argument, if
+// any, is the message
+type helper struct {
+	exception string
+	message   int
+}
+
+var helpers = map[string]helper{
+	"ThrowArgumentNull":        {"ArgumentNullException", 1},
+	"ThrowArgumentOutOfRange":  {"ArgumentOutOfRangeException", 2},
+	"ThrowInvalidOperation":    {"InvalidOperationException", 0},
+	"ThrowFileError":           {"FileException", 1},
+	"ThrowNetworkError":        {"NetworkException", 1},
+	"ThrowIfNil":               {"ArgumentNullException", -1},
+	"ThrowIOError":             {"IOException", 1},
+	"ThrowParseError":          {"ParseException", 3},
+	"ThrowIfScanError":         {"ParseException", -1},
+	"ThrowAuthentication":      {"AuthenticationException", 1},
+	"ThrowAuthorization":       {"AuthorizationException", -1},
+	"ThrowConcurrencyConflict": {"ConcurrencyException", -1},
+	"ThrowError":               {"WrappedErrorException", -1},
+	"ThrowErrorf":              {"WrappedErrorException", 0},
+	"ThrowIfError":             {"", -1}, // translated at run time
+}
+
+// generic throw functions, whose exception is given as an argument
+var generic = map[string]int{
+	"Throw":          0,
+	"ThrowIf":        1,
+	"ThrowWithInner": 0,
+}
+
+// IndexDir returns the throw sites of the Go files under root, skipping vendor,
+// testdata and hidden directories. Paths are relative to root. Sites throwing a type
+// retired by a Deprecate call found under root are flagged.
+func IndexDir(root string, tests bool) ([]ThrowSite, error) {
+	root = strings.TrimSuffix(root, "...")
+	if root == "" {
+		root = "."
+	}
+
+	var sites []ThrowSite
+	deprecated := make(map[string][]string)
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") || !tests && strings.HasSuffix(name, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			rel = path
+		}
+		found, deprecations := indexFile(fset, file)
+		for _, site := range found {
+			site.File = filepath.ToSlash(rel)
+			sites = append(sites, site)
+		}
+		for name, replacements := range deprecations {
+			deprecated[name] = replacements
+		}
+		return nil
+	})
+
+	for i := range sites {
+		if replacements, ok := deprecated[sites[i].Type]; ok {
+			sites[i].Deprecated, sites[i].Replacements = true, replacements
+		}
+	}
+	return sites, err
+}
+
+// indexFile finds the throw calls of a file that uses the package, or of the
+// package itself, and the exception types it deprecates with their replacements
+func indexFile(fset *token.FileSet, file *ast.File) ([]ThrowSite, map[string][]string) {
+	qualifier, dotted := importName(file)
+	if file.Name.Name == "goexceptions" {
+		dotted = true
+	}
+	if qualifier == "" && !dotted {
+		return nil, nil
+	}
+
+	var sites []ThrowSite
+	deprecated := make(map[string][]string)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		enclosing := funcName(fn)
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			if name, replacements, ok := deprecation(call, qualifier, dotted); ok {
+				deprecated[name] = replacements
+				return true
+			}
+			name := calledName(call.Fun, qualifier, dotted)
+			if name == "" {
+				return true
+			}
+			site, ok := describe(name, call, qualifier)
+			if ok {
+				site.Line = fset.Position(call.Pos()).Line
+				site.Function = enclosing
+				sites = append(sites, site)
+			}
+			return true
+		})
+	}
+	return sites, deprecated
+}
+
+// deprecation recognizes Deprecate[T](replacements...) and returns the names of T
+// and of the replacements given as composite literals
+func deprecation(call *ast.CallExpr, qualifier string, dotted bool) (string, []string, bool) {
+	index, ok := call.Fun.(*ast.IndexExpr)
+	if !ok {
+		return "", nil, false
+	}
+	switch f := index.X.(type) {
+	case *ast.Ident:
+		if !dotted || f.Name != "Deprecate" {
+			return "", nil, false
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := f.X.(*ast.Ident); !ok || qualifier == "" || pkg.Name != qualifier || f.Sel.Name != "Deprecate" {
+			return "", nil, false
+		}
+	default:
+		return "", nil, false
+	}
+
+	var replacements []string
+	for _, arg := range call.Args {
+		if unary, ok := arg.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+			arg = unary.X
+		}
+		if literal, ok := arg.(*ast.CompositeLit); ok {
+			replacements = append(replacements, typeName(literal.Type, qualifier))
+		}
+	}
+	return typeName(index.Index, qualifier), replacements, true
+}
+
+// importName returns the name the file imports the package under, and whether it is
+// dot-imported
+func importName(file *ast.File) (string, bool) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != modulePath {
+			continue
+		}
+		if spec.Name == nil {
+			return "goexceptions", false
+		}
+		if spec.Name.Name == "." {
+			return "", true
+		}
+		return spec.Name.Name, false
+	}
+	return "", false
+}
+
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	return typeName(fn.Recv.List[0].Type, "") + "." + fn.Name.Name
+}
+
+// calledName returns the name of a throw function called by fun, or "" if fun is
+// something else
+func calledName(fun ast.Expr, qualifier string, dotted bool) string {
+	switch f := fun.(type) {
+	case *ast.IndexExpr: // Throw[T](...)
+		return calledName(f.X, qualifier, dotted)
+	case *ast.Ident:
+		if dotted && isThrow(f.Name) {
+			return f.Name
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := f.X.(*ast.Ident); ok && qualifier != "" && pkg.Name == qualifier && isThrow(f.Sel.Name) {
+			return f.Sel.Name
+		}
+	}
+	return ""
+}
+
+func isThrow(name string) bool {
+	_, known := helpers[name]
+	_, isGeneric := generic[name]
+	return known || isGeneric
+}
+
+func describe(name string, call *ast.CallExpr, qualifier string) (ThrowSite, bool) {
+	site := ThrowSite{Call: name}
+	if h, ok := helpers[name]; ok {
+		site.Type = h.exception
+		if h.message >= 0 && h.message < len(call.Args) {
+			site.Message = messageOf(call.Args[h.message])
+		}
+		return site, true
+	}
+
+	index := generic[name]
+	if index >= len(call.Args) {
+		return site, false
+	}
+	arg := call.Args[index]
+	if unary, ok := arg.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		arg = unary.X
+	}
+	if literal, ok := arg.(*ast.CompositeLit); ok {
+		site.Type = typeName(literal.Type, qualifier)
+		for _, elt := range literal.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Message" {
+					site.Message = messageOf(kv.Value)
+				}
+			}
+		}
+	}
+	return site, true
+}
+
+// typeName renders a type expression, dropping the package's own qualifier so
+// built-in and custom exceptions read alike
+func typeName(expr ast.Expr, qualifier string) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return typeName(t.X, qualifier)
+	case *ast.IndexExpr:
+		return typeName(t.X, qualifier)
+	case *ast.IndexListExpr:
+		return typeName(t.X, qualifier)
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok {
+			if pkg.Name == qualifier {
+				return t.Sel.Name
+			}
+			return pkg.Name + "." + t.Sel.Name
+		}
+	}
+	return ""
+}
+
+// messageOf returns a string literal, or the format of a fmt.Sprintf call
+func messageOf(expr ast.Expr) string {
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) > 0 {
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Sprintf" {
+			expr = call.Args[0]
+		}
+	}
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		if value, err := strconv.Unquote(lit.Value); err == nil {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeSource(t *testing.T, dir, name, source string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIndexDir(t *testing.T) {
+	dir := t.TempDir()
+	writeSource(t, dir, "api/orders.go", `package api
+
+import (
+	"fmt"
+
+	ex "github.com/bencz/go-exceptions"
+)
+
+type Server struct{}
+
+func (s *Server) GetOrder(id string) {
+	ex.ThrowArgumentNull("id", "order id is required")
+	ex.Throw(ex.TimeoutException{Operation: "load", Message: fmt.Sprintf("order %s timed out", id)})
+	ex.ThrowIf(id == "x", &OrderException{Message: "bad order"})
+	ex.ThrowIfError(nil)
+}
+
+type OrderException struct{ Message string }
+`)
+	writeSource(t, dir, "api/orders_test.go", `package api
+
+import . "github.com/bencz/go-exceptions"
+
+func helper() { ThrowInvalidOperation("in a test") }
+`)
+	writeSource(t, dir, "other/other.go", `package other
+
+type thrower struct{}
+
+func (thrower) Throw(v any) {}
+
+func run() { thrower{}.Throw(1) }
+`)
+
+	sites, err := IndexDir(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ThrowSite{
+		{File: "api/orders.go", Line: 12, Function: "Server.GetOrder", Call: "ThrowArgumentNull", Type: "ArgumentNullException", Message: "order id is required"},
+		{File: "api/orders.go", Line: 13, Function: "Server.GetOrder", Call: "Throw", Type: "TimeoutException", Message: "order %s timed out"},
+		{File: "api/orders.go", Line: 14, Function: "Server.GetOrder", Call: "ThrowIf", Type: "OrderException", Message: "bad order"},
+		{File: "api/orders.go", Line: 15, Function: "Server.GetOrder", Call: "ThrowIfError"},
+	}
+	if !reflect.DeepEqual(sites, expected) {
+		t.Errorf("Unexpected sites:\n%+v\nexpected:\n%+v", sites, expected)
+	}
+
+	sites, err = IndexDir(dir+"/...", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) != 5 || sites[4].Function != "helper" || sites[4].Type != "InvalidOperationException" {
+		t.Errorf("Expected the dot-imported call in the test file, got %+v", sites)
+	}
+}
+
+func TestIndexDirDeprecated(t *testing.T) {
+	dir := t.TempDir()
+	writeSource(t, dir, "setup.go", `package billing
+
+import ex "github.com/bencz/go-exceptions"
+
+func init() {
+	ex.Deprecate[LegacyQuotaException](QuotaException{}, &ex.TimeoutException{})
+}
+`)
+	writeSource(t, dir, "quota.go", `package billing
+
+import . "github.com/bencz/go-exceptions"
+
+func charge() {
+	Throw(LegacyQuotaException{})
+	Throw(QuotaException{})
+}
+`)
+
+	sites, err := IndexDir(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) != 2 {
+		t.Fatalf("Deprecate calls are not throw sites, got %+v", sites)
+	}
+	legacy := sites[0]
+	if !legacy.Deprecated || !reflect.DeepEqual(legacy.Replacements, []string{"QuotaException", "TimeoutException"}) {
+		t.Errorf("Expected the legacy site to be flagged, got %+v", legacy)
+	}
+	if sites[1].Deprecated {
+		t.Errorf("Expected the replacement site not to be flagged, got %+v", sites[1])
+	}
+}
@@ -0,0 +1,49 @@
+// Command throwindex lists the places where exceptions are thrown, so questions such
+// as "which endpoints can produce TimeoutException" can be answered without running
+// the code. It prints a JSON array of call sites for the Go files under the given
+// directories:
+//
+//	throwindex ./...                  index the module, skipping tests
+//	throwindex -tests -type TimeoutException ./internal/api
+//	throwindex -deprecated ./...      sites still throwing types retired with Deprecate
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	tests := flag.Bool("tests", false, "include _test.go files")
+	only := flag.String("type", "", "only list sites throwing this exception type")
+	deprecated := flag.Bool("deprecated", false, "only list sites throwing deprecated exception types")
+	flag.Parse()
+
+	roots := flag.Args()
+	if len(roots) == 0 {
+		roots = []string{"."}
+	}
+
+	sites := []ThrowSite{}
+	for _, root := range roots {
+		found, err := IndexDir(root, *tests)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "throwindex:", err)
+			os.Exit(1)
+		}
+		for _, site := range found {
+			if (*only == "" || site.Type == *only) && (!*deprecated || site.Deprecated) {
+				sites = append(sites, site)
+			}
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(sites); err != nil {
+		fmt.Fprintln(os.Stderr, "throwindex:", err)
+		os.Exit(1)
+	}
+}
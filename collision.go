@@ -0,0 +1,163 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// TYPE NAME COLLISIONS: Distinct Go types reporting the same TypeName
+// ============================================================================
+
+// TypeNameCollisionException reports two distinct Go types with the same TypeName.
+// TypeName drives serialization and name-based routing, so a collision silently
+// sends one type's exceptions down the other's path.
+type TypeNameCollisionException struct {
+	Name        string // the colliding TypeName
+	Existing    string // fully qualified Go type seen first
+	Conflicting string // fully qualified Go type seen later
+}
+
+func (e TypeNameCollisionException) Error() string {
+	return fmt.Sprintf("TypeNameCollisionException: TypeName '%s' is reported by both %s and %s", e.Name, e.Existing, e.Conflicting)
+}
+
+func (e TypeNameCollisionException) TypeName() string {
+	return "TypeNameCollisionException"
+}
+
+var checkedTypes sync.Map // reflect.Type -> struct{}, fast path once a type is known
+
+var typeNamesMutex sync.Mutex
+var typeNames = make(map[string]reflect.Type)
+var collisions []TypeNameCollisionException
+
+// TypeNameCollisions returns the collisions detected so far
+func TypeNameCollisions() []TypeNameCollisionException {
+	typeNamesMutex.Lock()
+	defer typeNamesMutex.Unlock()
+	return append([]TypeNameCollisionException(nil), collisions...)
+}
+
+// checkTypeName records the TypeName of an exception type when it is registered or
+// first caught. A collision is reported to observers, and thrown in debug mode.
+// RemoteException is left out: its TypeName is the name of whatever type it stands
+// for.
+func checkTypeName(t reflect.Type, sample ExceptionType) {
+	if t == remoteExceptionType {
+		return
+	}
+	if _, known := checkedTypes.Load(t); known {
+		return
+	}
+	name, ok := sampleTypeName(sample)
+	if !ok {
+		return
+	}
+
+	typeNamesMutex.Lock()
+	if _, known := checkedTypes.LoadOrStore(t, struct{}{}); known {
+		typeNamesMutex.Unlock()
+		return
+	}
+	existing, exists := typeNames[name]
+	if !exists {
+		// built-in types are found by name before they are caught
+		existing, exists = builtinTypeNamed(name)
+		exists = exists && existing != t
+	}
+	if !exists {
+		typeNames[name] = t
+		typeNamesMutex.Unlock()
+		return
+	}
+	collision := TypeNameCollisionException{
+		Name:        name,
+		Existing:    qualifiedTypeName(existing),
+		Conflicting: qualifiedTypeName(t),
+	}
+	collisions = append(collisions, collision)
+	typeNamesMutex.Unlock()
+
+	if DebugMode() {
+		Throw(collision)
+	}
+	notifyException(EventUnhandled, &Exception{
+		Type:   collision,
+		Origin: collision.Conflicting,
+		Data:   make(map[string]interface{}),
+	}, "")
+}
+
+// checkTypeNameOf checks the zero value of T, for registrations by type parameter
+func checkTypeNameOf[T ExceptionType]() {
+	var zero T
+	checkTypeName(getTypeOf[T](), zero)
+}
+
+// sampleTypeName calls TypeName defensively: zero values of pointer types may not
+// support it
+func sampleTypeName(sample ExceptionType) (name string, ok bool) {
+	defer func() {
+		if recover() != nil {
+			name, ok = "", false
+		}
+	}()
+	return bindException(sample).TypeName(), true
+}
+
+// builtinExceptionTypes can be found by name before they are caught once, and are
+// registered for gob (see registerGobTypes)
+var builtinExceptionTypes = []ExceptionType{
+	ArgumentNullException{}, ArgumentOutOfRangeException{}, InvalidOperationException{},
+	FileException{}, NetworkException{}, IOException{}, ParseException{}, TemplateException{},
+	AggregateException{}, LifecycleException{}, TimeoutException{}, OperationCanceledException{},
+	RetryExhaustedException{}, AuthenticationException{}, AuthorizationException{},
+	ConcurrencyException{}, CircuitOpenException{}, WrappedErrorException{},
+	IndexOutOfRangeException{}, NilReferenceException{}, DivideByZeroException{}, TypeAssertionException{},
+	HandlerFailureException{}, HandlerTimeoutException{}, CleanupTimeoutException{},
+	ScheduledJobDisabledException{}, PluginException{}, PluginArgumentException{},
+	InjectedFaultException{}, DeprecatedThrowException{}, TypeNameCollisionException{},
+	BlockingHandlerException{}, SlowHandlerException{},
+}
+
+// exceptionTypeNamed returns the type whose TypeName is name, among the types
+// registered with RegisterExceptionType, the built-in types and the types the process
+// has otherwise registered or caught
+func exceptionTypeNamed(name string) (reflect.Type, bool) {
+	if t, registered := registeredTypeNamed(name); registered {
+		return t, true
+	}
+	typeNamesMutex.Lock()
+	t, known := typeNames[name]
+	typeNamesMutex.Unlock()
+	if known {
+		return t, true
+	}
+	return builtinTypeNamed(name)
+}
+
+func builtinTypeNamed(name string) (reflect.Type, bool) {
+	for _, sample := range builtinExceptionTypes {
+		if sample.TypeName() == name {
+			return reflect.TypeOf(sample), true
+		}
+	}
+	return nil, false
+}
+
+func qualifiedTypeName(t reflect.Type) string {
+	base := t
+	for base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	if base.PkgPath() == "" {
+		return t.String()
+	}
+	prefix := ""
+	for inner := t; inner.Kind() == reflect.Pointer; inner = inner.Elem() {
+		prefix += "*"
+	}
+	return prefix + base.PkgPath() + "." + base.Name()
+}
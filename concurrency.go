@@ -0,0 +1,67 @@
+package goexceptions
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ============================================================================
+// OPTIMISTIC CONCURRENCY: Conflicting writes to versioned entities
+// ============================================================================
+
+// ConcurrencyException is thrown when a write conflicts with a concurrent change:
+// the entity's version is no longer the one the operation read, or the database
+// aborted the transaction to keep it serializable. Its default policy is retryable
+// after reloading the entity (see ExceptionPolicy.Reload).
+type ConcurrencyException struct {
+	Entity          string
+	ExpectedVersion interface{} // version the operation read, nil when unknown
+	ActualVersion   interface{} // version found when writing, nil when unknown
+	Message         string
+}
+
+func (e ConcurrencyException) Error() string {
+	if e.ExpectedVersion == nil && e.ActualVersion == nil {
+		return fmt.Sprintf("ConcurrencyException: conflicting update of '%s'. %s", e.Entity, e.Message)
+	}
+	return fmt.Sprintf("ConcurrencyException: conflicting update of '%s' (expected version %v, found %v). %s",
+		e.Entity, formatSafe(e.ExpectedVersion), formatSafe(e.ActualVersion), e.Message)
+}
+
+func (e ConcurrencyException) TypeName() string {
+	return "ConcurrencyException"
+}
+
+func ThrowConcurrencyConflict(entity string, expectedVersion, actualVersion interface{}) {
+	Throw(ConcurrencyException{Entity: entity, ExpectedVersion: expectedVersion, ActualVersion: actualVersion})
+}
+
+// ConflictTranslator returns a translator mapping the conflict errors of an ORM or
+// driver, such as a version mismatch sentinel, to ConcurrencyException:
+//
+//	RegisterTranslator(ConflictTranslator(ent.ErrVersionMismatch, store.ErrStaleObject))
+func ConflictTranslator(sentinels ...error) Translator {
+	return func(err error) (ExceptionType, bool) {
+		for _, sentinel := range sentinels {
+			if errors.Is(err, sentinel) {
+				return ConcurrencyException{Message: err.Error()}, true
+			}
+		}
+		return nil, false
+	}
+}
+
+// translateConcurrencyError recognizes SQL serialization failures and deadlocks
+// (SQLSTATE 40001 and 40P01) from drivers whose errors report their SQLSTATE, such
+// as pgx and lib/pq
+func translateConcurrencyError(err error) (ExceptionType, bool) {
+	var sqlErr interface{ SQLState() string }
+	if !errors.As(err, &sqlErr) {
+		return nil, false
+	}
+	switch sqlErr.SQLState() {
+	case "40001", "40P01":
+		return ConcurrencyException{Message: err.Error()}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,124 @@
+package goexceptions
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// ============================================================================
+// RUNTIME CONTROL: Switch diagnostics on and off without a restart
+// ============================================================================
+
+// Subsystem names a diagnostic feature that can be switched at run time
+type Subsystem string
+
+const (
+	// SubsystemStacks captures stack traces; when off, exceptions only keep their Origin
+	SubsystemStacks Subsystem = "stacks"
+	// SubsystemHistory records caught exceptions (see RangeHistory)
+	SubsystemHistory Subsystem = "history"
+	// SubsystemObservers delivers events to observers
+	SubsystemObservers Subsystem = "observers"
+	// SubsystemSampling applies SetStackSampling; when off, every stack is captured
+	SubsystemSampling Subsystem = "sampling"
+	// SubsystemDebug runs the checks of debug mode (see SetDebugMode)
+	SubsystemDebug Subsystem = "debug"
+	// SubsystemStrict throws unhandled exceptions again when chains end (see SetStrictMode)
+	SubsystemStrict Subsystem = "strict"
+)
+
+// EnvConfig is the environment variable read by ConfigureFromEnv
+const EnvConfig = "GOEXCEPTIONS"
+
+// Every subsystem but debug and strict is on by default, so these flags record the opposite
+var stacksOff, historyOff, observersOff, samplingOff atomic.Bool
+
+func subsystemFlag(subsystem Subsystem) (flag *atomic.Bool, inverted bool, ok bool) {
+	switch subsystem {
+	case SubsystemStacks:
+		return &stacksOff, true, true
+	case SubsystemHistory:
+		return &historyOff, true, true
+	case SubsystemObservers:
+		return &observersOff, true, true
+	case SubsystemSampling:
+		return &samplingOff, true, true
+	case SubsystemDebug:
+		return &debugMode, false, true
+	case SubsystemStrict:
+		return &strictMode, false, true
+	}
+	return nil, false, false
+}
+
+// SetEnabled switches a subsystem on or off, taking effect for the next exceptions.
+// Settings such as the history limit or the sampling rate are kept while a subsystem
+// is off. It returns an error for an unknown subsystem.
+func SetEnabled(subsystem Subsystem, enabled bool) error {
+	flag, inverted, ok := subsystemFlag(subsystem)
+	if !ok {
+		return fmt.Errorf("goexceptions: unknown subsystem %q", subsystem)
+	}
+	flag.Store(enabled != inverted)
+	return nil
+}
+
+// Enabled reports whether a subsystem is on. Unknown subsystems are off.
+func Enabled(subsystem Subsystem) bool {
+	flag, inverted, ok := subsystemFlag(subsystem)
+	return ok && flag.Load() != inverted
+}
+
+// Subsystems returns the state of every subsystem, for status pages
+func Subsystems() map[Subsystem]bool {
+	states := make(map[Subsystem]bool, 6)
+	for _, subsystem := range []Subsystem{SubsystemStacks, SubsystemHistory, SubsystemObservers, SubsystemSampling, SubsystemDebug, SubsystemStrict} {
+		states[subsystem] = Enabled(subsystem)
+	}
+	return states
+}
+
+// Configure applies a comma-separated list of subsystem settings such as
+// "debug=on,sampling=off,history=off", as an operator would send to an admin
+// endpoint. Values are on/off, true/false or 1/0. Nothing is applied if an entry is
+// invalid.
+func Configure(spec string) error {
+	settings := make(map[Subsystem]bool)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, found := strings.Cut(entry, "=")
+		if !found {
+			return fmt.Errorf("goexceptions: setting %q is not name=value", entry)
+		}
+		subsystem := Subsystem(strings.ToLower(strings.TrimSpace(name)))
+		if _, _, ok := subsystemFlag(subsystem); !ok {
+			return fmt.Errorf("goexceptions: unknown subsystem %q", subsystem)
+		}
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "on", "true", "1":
+			settings[subsystem] = true
+		case "off", "false", "0":
+			settings[subsystem] = false
+		default:
+			return fmt.Errorf("goexceptions: invalid value %q for %s", value, subsystem)
+		}
+	}
+
+	for subsystem, enabled := range settings {
+		SetEnabled(subsystem, enabled)
+	}
+	return nil
+}
+
+// ConfigureFromEnv applies the settings of the GOEXCEPTIONS environment variable
+// with Configure, typically at startup:
+//
+//	GOEXCEPTIONS=debug=on,stacks=off ./server
+func ConfigureFromEnv() error {
+	return Configure(os.Getenv(EnvConfig))
+}
@@ -0,0 +1,32 @@
+package goexceptions
+
+// ============================================================================
+// TYPED DATA KEYS: Compile-time checked access to Exception.Data
+// ============================================================================
+
+// DataKey names an Exception.Data entry holding values of type T
+type DataKey[T any] struct {
+	Name string
+}
+
+// Keys for metadata commonly attached to exceptions
+var (
+	RequestIDKey = DataKey[string]{Name: "request_id"}
+	UserIDKey    = DataKey[string]{Name: "user_id"}
+	TenantKey    = DataKey[string]{Name: "tenant"}
+)
+
+// SetTyped stores value under key in the exception's Data
+func SetTyped[T any](ex *Exception, key DataKey[T], value T) {
+	if ex.Data == nil {
+		ex.Data = make(map[string]interface{})
+	}
+	ex.Data[key.Name] = value
+}
+
+// GetTyped returns the value stored under key. It returns false when the entry is
+// missing or holds a value of another type, such as one set through the untyped map.
+func GetTyped[T any](ex *Exception, key DataKey[T]) (T, bool) {
+	value, ok := ex.Data[key.Name].(T)
+	return value, ok
+}
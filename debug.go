@@ -0,0 +1,20 @@
+package goexceptions
+
+import "sync/atomic"
+
+// ============================================================================
+// DEBUG MODE: Extra safety checks for development and tests
+// ============================================================================
+
+var debugMode atomic.Bool
+
+// SetDebugMode enables checks that are too costly or too strict for production,
+// such as detecting blocking handlers (see SetBlockingHandlerThreshold)
+func SetDebugMode(enabled bool) {
+	debugMode.Store(enabled)
+}
+
+// DebugMode reports whether debug checks are enabled
+func DebugMode() bool {
+	return debugMode.Load()
+}
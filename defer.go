@@ -0,0 +1,59 @@
+package goexceptions
+
+// ============================================================================
+// DEFER STACK: Cleanups registered along a chain, run in LIFO order
+// ============================================================================
+
+// Defer registers cleanup to run when the chain ends (Finally, End, Rethrow), after
+// the Finally cleanup, in the reverse order of registration like Go's defer
+// statements. Each cleanup runs on its own: a panic is converted into an exception,
+// reported to observers as unhandled and recorded in DeferFailures, and the
+// remaining cleanups still run. Cleanups registered after the chain ended run
+// immediately.
+func (tr *TryResult) Defer(cleanup func()) *TryResult {
+	if tr == nil {
+		return tr
+	}
+	if tr.completed {
+		tr.runCleanup(cleanup)
+		return tr
+	}
+	tr.deferred = append(tr.deferred, cleanup)
+	return tr
+}
+
+// DeferFailures returns the exceptions raised by cleanups registered with Defer
+func (tr *TryResult) DeferFailures() []*Exception {
+	if tr == nil {
+		return nil
+	}
+	return tr.deferFailures
+}
+
+// runDeferred runs the registered cleanups, most recent first
+func (tr *TryResult) runDeferred() {
+	for i := len(tr.deferred) - 1; i >= 0; i-- {
+		tr.runCleanup(tr.deferred[i])
+	}
+	tr.deferred = nil
+}
+
+func (tr *TryResult) runCleanup(cleanup func()) {
+	if failure := captureCleanup(cleanup); failure != nil {
+		if failure.Origin == "" && len(failure.StackTrace) > 0 {
+			failure.Origin = failure.StackTrace[0]
+		}
+		tr.deferFailures = append(tr.deferFailures, failure)
+		tr.emit(EventUnhandled, failure, "")
+	}
+}
+
+func captureCleanup(cleanup func()) (failure *Exception) {
+	defer func() {
+		if r := recover(); r != nil {
+			failure = exceptionFromPanic(r)
+		}
+	}()
+	cleanup()
+	return nil
+}
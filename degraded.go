@@ -0,0 +1,44 @@
+package goexceptions
+
+// ============================================================================
+// DEGRADED OUTCOMES: Recovered, but with reduced functionality
+// ============================================================================
+
+// Degraded marks the outcome of the Try as recovered with reduced functionality.
+// Handlers call it on the exception they receive:
+//
+//	Handler[NetworkException](func(ex NetworkException, full Exception) {
+//	    useStaleCache()
+//	    full.Degraded("served stale cache")
+//	})
+func (e *Exception) Degraded(reason string) {
+	if e.owner != nil {
+		e.owner.Degraded(reason)
+	}
+}
+
+// Degraded marks the outcome as recovered with reduced functionality
+func (tr *TryResult) Degraded(reason string) *TryResult {
+	if tr == nil {
+		return tr
+	}
+	tr.degradedReason = reason
+	if reason == "" {
+		tr.degradedReason = "degraded"
+	}
+	tr.emit(EventDegraded, tr.exception, tr.degradedReason)
+	return tr
+}
+
+// IsDegraded reports whether a handler marked the outcome as degraded
+func (tr *TryResult) IsDegraded() bool {
+	return tr != nil && tr.degradedReason != ""
+}
+
+// DegradedReason returns the reason given to Degraded, or "" if not degraded
+func (tr *TryResult) DegradedReason() string {
+	if tr == nil {
+		return ""
+	}
+	return tr.degradedReason
+}
@@ -0,0 +1,111 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ============================================================================
+// DEPRECATION: Migrate throw sites away from retired exception types
+// ============================================================================
+
+// DeprecatedThrowException is reported to observers, with EventWarning, the first time
+// each throw site throws a deprecated exception type (see Deprecate). The thrown
+// exception is its inner exception.
+type DeprecatedThrowException struct {
+	Deprecated   string   // TypeName of the deprecated type
+	Replacements []string // TypeNames of the types to throw instead
+	Origin       string   // throw site
+}
+
+func (e DeprecatedThrowException) Error() string {
+	if len(e.Replacements) == 0 {
+		return fmt.Sprintf("DeprecatedThrowException: '%s' is deprecated, thrown at %s", e.Deprecated, e.Origin)
+	}
+	return fmt.Sprintf("DeprecatedThrowException: '%s' is deprecated, throw %s instead, thrown at %s", e.Deprecated, strings.Join(e.Replacements, " or "), e.Origin)
+}
+
+func (e DeprecatedThrowException) TypeName() string {
+	return "DeprecatedThrowException"
+}
+
+var deprecationsMutex sync.RWMutex
+var deprecations = make(map[reflect.Type][]string)
+var deprecationsSet atomic.Bool
+
+// deprecatedSites records the throw sites already warned about, per type
+var deprecatedSites sync.Map // deprecatedSite -> struct{}
+
+type deprecatedSite struct {
+	t      reflect.Type
+	origin string
+}
+
+// Deprecate marks exceptions of type T as deprecated in favor of replacements. Each
+// throw site still throwing T reports a DeprecatedThrowException to observers once,
+// and cmd/throwindex flags those sites when it finds the Deprecate call:
+//
+//	Deprecate[LegacyPaymentException](PaymentDeclinedException{}, PaymentTimeoutException{})
+func Deprecate[T ExceptionType](replacements ...ExceptionType) {
+	checkTypeNameOf[T]()
+	names := make([]string, len(replacements))
+	for i, replacement := range replacements {
+		names[i] = bindException(replacement).TypeName()
+	}
+
+	deprecationsMutex.Lock()
+	defer deprecationsMutex.Unlock()
+	deprecations[getTypeOf[T]()] = names
+	deprecationsSet.Store(true)
+}
+
+// Undeprecate removes the deprecation of T, and forgets the sites already reported
+func Undeprecate[T ExceptionType]() {
+	t := getTypeOf[T]()
+
+	deprecationsMutex.Lock()
+	delete(deprecations, t)
+	deprecationsSet.Store(len(deprecations) > 0)
+	deprecationsMutex.Unlock()
+
+	deprecatedSites.Range(func(key, _ any) bool {
+		if key.(deprecatedSite).t == t {
+			deprecatedSites.Delete(key)
+		}
+		return true
+	})
+}
+
+// warnDeprecated reports the first throw of a deprecated type at each site
+func warnDeprecated(ex *Exception) {
+	if !deprecationsSet.Load() {
+		return
+	}
+	t := reflect.TypeOf(ex.Type)
+	deprecationsMutex.RLock()
+	replacements, deprecated := deprecations[t]
+	deprecationsMutex.RUnlock()
+	if !deprecated {
+		return
+	}
+	if _, seen := deprecatedSites.LoadOrStore(deprecatedSite{t: t, origin: ex.Origin}, struct{}{}); seen {
+		return
+	}
+
+	thrown := *ex
+	tr := newTryResult(nil)
+	tr.exception = &Exception{
+		Type: DeprecatedThrowException{
+			Deprecated:   ex.TypeName(),
+			Replacements: replacements,
+			Origin:       ex.Origin,
+		},
+		Origin: ex.Origin,
+		Data:   make(map[string]interface{}),
+		Inner:  &thrown,
+	}
+	tr.notify(EventWarning)
+}
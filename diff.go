@@ -0,0 +1,137 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// EXCEPTION DIFF: Detect drift in the error contract between two versions
+// ============================================================================
+
+// DiffKind classifies a difference between two exceptions
+type DiffKind int
+
+const (
+	// DiffType: the TypeName at some depth of the chain changed
+	DiffType DiffKind = iota
+	// DiffChain: the chain of inner exceptions got longer or shorter
+	DiffChain
+	// DiffCode: the Code field (see SimpleException) changed
+	DiffCode
+	// DiffDataKeys: Data keys were added or removed
+	DiffDataKeys
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case DiffType:
+		return "type"
+	case DiffChain:
+		return "chain"
+	case DiffCode:
+		return "code"
+	case DiffDataKeys:
+		return "data_keys"
+	default:
+		return "unknown"
+	}
+}
+
+// ExceptionChange is one difference found by DiffExceptions
+type ExceptionChange struct {
+	Kind  DiffKind
+	Depth int // 0 for the outer exception, 1 for its inner exception, ...
+	Old   string
+	New   string
+}
+
+func (c ExceptionChange) String() string {
+	return fmt.Sprintf("%s at depth %d: %q -> %q", c.Kind, c.Depth, c.Old, c.New)
+}
+
+// DiffReport lists the differences between two exceptions
+type DiffReport struct {
+	Changes []ExceptionChange
+}
+
+// Empty reports whether the exceptions have the same contract
+func (r DiffReport) Empty() bool {
+	return len(r.Changes) == 0
+}
+
+func (r DiffReport) String() string {
+	if r.Empty() {
+		return "no changes"
+	}
+	lines := make([]string, 0, len(r.Changes))
+	for _, change := range r.Changes {
+		lines = append(lines, change.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DiffExceptions compares the parts of two exceptions that consumers depend on: the
+// type at each depth of the chain, the chain's length, codes and Data keys. Messages,
+// stacks and Data values are not compared. A nil exception counts as an empty chain.
+func DiffExceptions(old, new *Exception) DiffReport {
+	var report DiffReport
+	add := func(kind DiffKind, depth int, oldValue, newValue string) {
+		report.Changes = append(report.Changes, ExceptionChange{Kind: kind, Depth: depth, Old: oldValue, New: newValue})
+	}
+
+	oldChain, newChain := old.GetAllExceptions(), new.GetAllExceptions()
+	if len(oldChain) != len(newChain) {
+		add(DiffChain, 0, chainShape(oldChain), chainShape(newChain))
+	}
+
+	for depth := 0; depth < len(oldChain) && depth < len(newChain); depth++ {
+		o, n := oldChain[depth], newChain[depth]
+		if o.TypeName() != n.TypeName() {
+			add(DiffType, depth, o.TypeName(), n.TypeName())
+		}
+		if oldCode, newCode := codeOf(o.Type), codeOf(n.Type); oldCode != newCode {
+			add(DiffCode, depth, oldCode, newCode)
+		}
+		if oldKeys, newKeys := dataKeys(o), dataKeys(n); oldKeys != newKeys {
+			add(DiffDataKeys, depth, oldKeys, newKeys)
+		}
+	}
+	return report
+}
+
+func chainShape(chain []*Exception) string {
+	names := make([]string, 0, len(chain))
+	for _, ex := range chain {
+		names = append(names, ex.TypeName())
+	}
+	return strings.Join(names, " > ")
+}
+
+// codeOf returns the string Code field of an exception type, such as the one
+// promoted from an embedded SimpleException, or ""
+func codeOf(exceptionType ExceptionType) string {
+	value := reflect.ValueOf(exceptionType)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+	code := value.FieldByName("Code")
+	if !code.IsValid() || code.Kind() != reflect.String {
+		return ""
+	}
+	return code.String()
+}
+
+func dataKeys(ex *Exception) string {
+	keys := make([]string, 0, len(ex.Data))
+	for key := range ex.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
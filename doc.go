@@ -55,6 +55,14 @@ high performance, comprehensive testing (97.2% coverage), and clean APIs.
 	    }),
 	)
 
+Controller-style types can declare handlers as methods named HandleXxx and
+register them all at once:
+
+	func (c *UserController) HandleNotFound(ex NotFoundException, full Exception) { c.status = 404 }
+	func (c *UserController) HandleAny(full Exception) { c.status = 500 }
+
+	Try(c.loadUser).Handle(HandlersFrom(c)...)
+
 # Builder Pattern Syntax
 
 	Try(func() {
@@ -75,6 +83,47 @@ high performance, comprehensive testing (97.2% coverage), and clean APIs.
 	    notifyAdministrators(ex)
 	})
 
+Sentinel errors from the standard library can select handlers directly. Errors
+passed to ThrowIfError or panicked are kept as the cause of the exception:
+
+	Try(func() {
+	    ThrowIfError(row.Scan(&user))
+	}).Handle(
+	    HandlerSentinel(sql.ErrNoRows, func(ex Exception) { notFound(w) }),
+	    HandlerAny(func(ex Exception) { internalError(w) }),
+	)
+
+A typed handler catches its type and, when the type is an interface, every type
+implementing it, which gives exception hierarchies: Handler[ArgumentException]
+catches ArgumentNullException and ArgumentOutOfRangeException, and
+Handler[ExceptionType] catches everything.
+
+	Try(validate).Handle(
+	    Handler[ArgumentException](func(ex ArgumentException, full Exception) {
+	        http.Error(w, "invalid "+ex.ArgumentName(), 400)
+	    }),
+	)
+
+HandlerMatch takes a Matcher instead: the built-in MatchExact, MatchAssignable,
+MatchInterface, MatchName, MatchNamedType and MatchTag, or a MatcherFunc for custom
+rules such as a range of error codes:
+
+	Try(callUpstream).Handle(
+	    HandlerMatch(MatchInterface[Temporary](), func(ex Exception) { scheduleRetry() }),
+	    HandlerMatch(MatchName("QuotaException", "RateLimitException"), throttle),
+	)
+
+Enricher annotates every exception without consuming it, unlike HandlerAny. It
+runs before the other handlers wherever it is listed, so they see its data:
+
+	Try(serve).Handle(Enricher(func(ex *Exception) { ex.Data["request_id"] = id }), handlers...)
+
+Restructuring handlers can be tried in shadow mode first: the candidate set is only
+probed (see HandlerProbe), and exceptions it would route differently are reported:
+
+	shadow := NewShadow(logDivergence, Handler[ArgumentException](badRequest), HandlerAny(fail))
+	Try(save).HandleShadowed(shadow, currentHandlers...)
+
 # Finally Blocks
 
 	Try(func() {
@@ -89,6 +138,28 @@ high performance, comprehensive testing (97.2% coverage), and clean APIs.
 	    cleanupTempFiles()
 	})
 
+Cleanup that may hang on a dead resource can be time-boxed. FinallyWithin returns
+once the deadline passes and reports a CleanupTimeoutException to observers:
+
+	Try(query, WithName("close-db")).Any(logIt).FinallyWithin(2*time.Second, db.Close)
+
+Defer stacks cleanups on the chain. They run when it ends, after Finally, most
+recent first, each with its own exception capture (see DeferFailures):
+
+	Try(migrate).Defer(conn.Close).Defer(lock.Release).Any(logIt).End()
+
+Else runs only when the block completed without an exception:
+
+	Try(save).Else(func() { metrics.Saved.Inc() }).Any(logIt).End()
+
+# Returning Values
+
+Try1 and Try2 keep what the block returns. OrElse gives it back, or a fallback if
+the block threw:
+
+	port := Try1(func() int { return parsePort(s) }).Any(logIt).OrElse(8080)
+	host, port := Try2(splitHostPort).End().OrElse("localhost", 80)
+
 # Built-in Exception Types
 
 - ArgumentNullException - For null/nil parameter validation
@@ -96,8 +167,27 @@ high performance, comprehensive testing (97.2% coverage), and clean APIs.
 - InvalidOperationException - For invalid state operations
 - FileException - For file system operations
 - NetworkException - For network-related errors
+- IOException - For failed reads and writes
+- ParseException - For malformed input, with line/column when known
+- TemplateException - For failed html/template and text/template execution
+- AggregateException - For several independent failures reported together
+- LifecycleException - For failed startup/shutdown phases
+- TimeoutException - For operations that missed their deadline
+- OperationCanceledException - For operations abandoned before completing
+- CircuitOpenException - For calls short-circuited by an open CircuitBreaker
+- AuthenticationException - For callers whose identity cannot be established
+- AuthorizationException - For subjects denied an action on a resource
+- ConcurrencyException - For optimistic-lock conflicts and serialization failures
+- WrappedErrorException - For plain errors thrown with ThrowError or ThrowErrorf
 - Exception - Base exception type
 
+HTTPStatusFor maps an exception to the status code an HTTP handler should answer
+with: 400 for argument validation, 401 and 403 for authentication and authorization,
+409 for concurrency conflicts, 503 for open circuits, 504 for timeouts, and 500
+otherwise. RegisterHTTPStatus overrides it per type.
+
+	w.WriteHeader(HTTPStatusFor(&full))
+
 # Helper Functions
 
 	// Validation helpers
@@ -111,6 +201,10 @@ high performance, comprehensive testing (97.2% coverage), and clean APIs.
 	ThrowFileError("config.json", "Configuration file not found", err)
 	ThrowNetworkError("https://api.example.com", "Connection timeout", err)
 
+	// Any error, kept for errors.Is and errors.As
+	ThrowError(err)
+	ThrowErrorf("loading %s: %w", path, err)
+
 # Custom Exception Types
 
 Create custom exceptions by implementing the ExceptionType interface:
@@ -146,6 +240,41 @@ Create custom exceptions by implementing the ExceptionType interface:
 	    }),
 	)
 
+Instead of writing TypeName by hand, embed AutoTypeName parameterized with the
+exception type itself; TypeNameOf[T]() returns the same derived name:
+
+	type QuotaExceededException struct {
+	    AutoTypeName[QuotaExceededException]
+	    Limit int
+	}
+
+For the common case, embed SimpleException (Message, Code, Cause) and declare only
+the extra fields; Error and TypeName are generated for the outer type when thrown:
+
+	type PaymentDeclinedException struct {
+	    SimpleException
+	    OrderID string
+	}
+
+Hierarchies are declared by embedding the parent type, with BaseException at the
+root. A handler for a parent catches its descendants and receives the embedded
+parent; TypeName still names the thrown type (see TypeHierarchy):
+
+	type PaymentException struct{ BaseException }
+	type CardDeclinedException struct {
+	    PaymentException
+	    Card string
+	}
+
+	Try(charge).Handle(Handler[PaymentException](func(ex PaymentException, full Exception) {
+	    refund(full.TypeName())
+	}))
+
+TypeNames must be unique across packages. When two distinct Go types report the
+same TypeName, at registration (RegisterPolicy, Preload, ...) or when first caught,
+a TypeNameCollisionException is reported to observers, or thrown in debug mode.
+See TypeNameCollisions.
+
 # Nested Exceptions
 
 Build exception chains with inner exceptions:
@@ -166,6 +295,533 @@ Build exception chains with inner exceptions:
 	    }),
 	)
 
+Throw records a new throw site, so inside a handler use full.Rethrow() to let the
+exception propagate with its original stack trace and origin, or
+full.RethrowAs(newEx) to throw newEx with the original as its inner exception.
+TryResult.Rethrow and TryResult.RethrowAs do the same for an unhandled exception.
+
+Exception implements fmt.Formatter the way pkg/errors does: %s and %v print the
+message, %+v the whole chain with stack traces, and %#v a dump with redacted data:
+
+	log.Printf("sync failed: %+v", full)
+
+AggregateException reports several failures at once. Flatten expands nested
+aggregates, Range visits them, and Unwrap lets errors.Is and errors.As search each
+one:
+
+	Throw(AggregateException{Message: "validation failed", Exceptions: failures})
+
+TryAll runs blocks concurrently and aggregates their failures. TryAllFailFast
+cancels the context of the other blocks as soon as one throws:
+
+	TryAll(loadUser, loadOrders).Handle(Handler[AggregateException](partialPage))
+	TryAllFailFast(ctx, fetchQuote, fetchStock).Any(logIt)
+
+A panic in a plain goroutine bypasses every Try and crashes the process. Go starts
+the goroutine with its own capture, offering exceptions to handlers and reporting
+the rest to observers:
+
+	Go(func() { reindex(batch) }, Handler[IOException](retryLater))
+
+ExceptionGroup is errgroup for exceptions: Go starts goroutines, optionally bounded
+by SetLimit, the group's context is canceled by the first exception, and Wait
+returns it:
+
+	group, ctx := NewExceptionGroup(ctx)
+	for _, id := range ids {
+	    group.Go(func() { fetch(ctx, id) })
+	}
+	if ex := group.Wait(); ex != nil { ... }
+
+AttachToContext hands an exception captured in one layer, such as middleware, to a
+later stage or parallel branch through the context, which is marked handled by the
+hand-off; ExceptionFromContext returns it there:
+
+	ctx := AttachToContext(r.Context(), Try(func() { authenticate(r) }))
+	if ex := ExceptionFromContext(ctx); ex != nil { ... }
+
+In strict mode (SetStrictMode, or WithStrict per Try) a chain ending with Finally or
+End throws its exception again if no handler consumed it, so forgotten handlers
+show up instead of silently swallowing failures.
+
+# Streaming I/O
+
+ThrowingReader and ThrowingWriter turn read and write errors into IOException, so
+streaming code inside a Try needs no per-call error checks. io.EOF is still returned
+normally:
+
+	Try(func() {
+	    io.Copy(ThrowingWriter(dst), ThrowingReader(src))
+	}).Handle(
+	    Handler[IOException](func(ex IOException, full Exception) { ... }),
+	)
+
+# Awaiting Channels
+
+Select-with-timeout boilerplate becomes a single exception-aware call:
+
+	price := AwaitOrThrow(prices, 2*time.Second, "pricing") // TimeoutException
+	user := ReceiveCtx(ctx, users)                          // TimeoutException or OperationCanceledException
+
+The context error is the cause of these exceptions, and the cause given to
+context.WithCancelCause or WithTimeoutCause becomes their inner exception, so the
+chain tells a client disconnect from a parent timeout.
+
+Translators map context.Canceled to OperationCanceledException and
+context.DeadlineExceeded to TimeoutException, never to InvalidOperationException, so
+cancellations stay out of failure metrics and retries. Canceling with the
+ErrCanceledByUser cause sets ByUser:
+
+	cancel(ErrCanceledByUser)
+
+TryCtx passes the context to the block, which stops with ThrowIfDone at points
+where it can, and handlers get the context back from Exception.Context:
+
+	TryCtx(r.Context(), func(ctx context.Context) {
+	    for _, item := range items {
+	        ThrowIfDone(ctx)
+	        process(ctx, item)
+	    }
+	}).Handle(Handler[OperationCanceledException](stopped))
+
+Blocks that cannot observe a context are bounded with TryWithTimeout, which stops
+waiting at the deadline and throws a TimeoutException, leaving the block running:
+
+	TryWithTimeout(2*time.Second, render, WithName("render")).Handle(fallbackPage)
+
+A Pipeline enforces an end-to-end latency budget, split across its steps by weight.
+A step overrunning its slice throws a TimeoutException naming the step:
+
+	NewPipeline("checkout", 800*time.Millisecond).
+	    Step("reserve", 1, reserve).
+	    Step("charge", 2, charge).
+	    Run(ctx)
+
+# Error Translation
+
+Errors entering the exception system (panicked errors, ThrowIfError) go through
+translators. Built-in translators turn csv, strconv and bufio.Scanner errors into
+ParseException with position information; custom ones can be registered:
+
+	remove := RegisterTranslator(func(err error) (ExceptionType, bool) {
+	    if errors.Is(err, sql.ErrNoRows) {
+	        return NotFoundException{Message: err.Error()}, true
+	    }
+	    return nil, false
+	})
+
+	ThrowIfError(err)
+
+Errors carrying a github.com/pkg/errors stack keep it: ThrowIfError and panicked
+errors use the recorded stack instead of the throw site's (see StackTraceOf).
+
+SQL serialization failures and deadlocks (SQLSTATE 40001 and 40P01) become
+ConcurrencyException, which is retryable after reloading the entity. ORM-specific
+conflict errors can be mapped to it with ConflictTranslator:
+
+	RegisterTranslator(ConflictTranslator(store.ErrVersionMismatch))
+
+In the other direction, an Exception returned as a plain error still works with
+errors.Is and errors.As: Unwrap exposes the original error or Cause and the inner
+exception, and As extracts the exception type, an embedded parent type or a pointer
+to either:
+
+	var timeout TimeoutException
+	if errors.Is(err, sql.ErrNoRows) || errors.As(err, &timeout) { ... }
+
+Libraries that use exceptions internally can still return errors publicly. AsError
+ends the chain and returns the unhandled exception as an ExceptionError, a snapshot
+keeping the type name, message, redacted data and stack:
+
+	return order, Try(func() { order = c.fetch(id) }).Handle(notFound).AsError()
+
+Err returns the exception as an ExceptionError whether or not it was handled,
+without ending the chain.
+
+# Gob and net/rpc
+
+Exception implements gob.GobEncoder and gob.GobDecoder, so it can travel in net/rpc
+replies. Built-in types are registered automatically; register custom types with
+RegisterExceptionType. Causes arrive as RemoteError:
+
+	RegisterExceptionType[DatabaseException]()
+	err := SendException(enc, tr.GetException())
+	ex, err := ReceiveException(dec)
+
+# JSON
+
+Exception implements json.Marshaler and json.Unmarshaler with a stable schema:
+type, message, code, fields, origin, stack, redacted data, cause and inner chain.
+Decoding rebuilds the concrete type when the process knows its name, which built-in
+types and types registered or preloaded are; others become a RemoteException.
+RegisterExceptionType makes custom types known by name up front, for JSON, gob and
+MatchNamedType, which catches by names read from configuration:
+
+	payload, err := json.Marshal(full) // {"type":"NetworkException","fields":{"URL":...},"inner":{...}}
+	var ex Exception
+	err = json.Unmarshal(payload, &ex)
+
+cmd/exceptionctl reads such exceptions back from JSON, JSON lines and dead-letter
+records or CloudEvents embedding them, and prints chains, a Graphviz graph of them,
+or counts per fingerprint, filtered by type or fingerprint:
+
+	go run github.com/bencz/go-exceptions/cmd/exceptionctl stats -type TimeoutException failures.jsonl
+
+# Templates
+
+ExecuteTemplate and RenderTemplate run html/template or text/template templates and
+turn execution errors and panics into TemplateException, including the failing node:
+
+	page := RenderTemplate(tmpl, data) // throws TemplateException; nothing partial is written
+
+# Stack Sampling
+
+Every thrown exception records its Origin (the throw site) and a Fingerprint
+combining type and origin. For noisy, expected exceptions the full stack capture
+can be sampled per fingerprint; the first occurrence always keeps its stack:
+
+	SetStackSampling(100) // full stacks for 1 in 100 throws of the same fingerprint
+
+# Runtime Control
+
+Stack capture, history, observers, sampling, debug checks and strict mode can be switched while
+the process runs, to crank diagnostics up during an incident and back down after:
+
+	SetEnabled(SubsystemSampling, false)   // every stack, whatever SetStackSampling says
+	err := Configure("debug=on,history=off") // e.g. from an admin endpoint
+	err = ConfigureFromEnv()                 // GOEXCEPTIONS=debug=on,stacks=off
+
+# Typed Data Keys
+
+DataKey gives Exception.Data entries a compile-time type. RequestIDKey, UserIDKey and
+TenantKey cover the most common metadata:
+
+	SetTyped(&full, RequestIDKey, r.Header.Get("X-Request-ID"))
+	attempt, ok := GetTyped(&full, DataKey[int]{Name: "attempt"})
+
+# Data Redaction
+
+Sensitive values attached to Exception.Data can be hidden from anything that renders,
+serializes or reports exceptions, while remaining available to local handlers:
+
+	RedactKeys("password", "*token*")
+	safe := full.RedactedData()
+
+Rendered values are also bounded by SafeValue: a self-referencing struct, a map
+containing itself or a slice of a million elements is replaced by a truncated copy
+with cycles marked "[cycle]", so diagnostics cannot panic or stall on them.
+
+# Handler Isolation
+
+A handler that panics (a nil map in a logging handler, say) does not crash the caller:
+the panic is converted into a HandlerFailureException chained to the original
+exception, recorded on the TryResult and reported to observers, and Finally still
+runs. Exceptions thrown on purpose from a handler keep propagating.
+
+	if tr.HandlerFailed() {
+	    log.Print(tr.HandlerFailure().GetFullMessage())
+	}
+
+In debug mode, a handler still running after SetBlockingHandlerThreshold (100ms by
+default) is reported to observers as a BlockingHandlerException with EventDiagnostic,
+catching handlers that make synchronous network calls on hot error paths.
+
+To protect request latency from slow recovery logic, HandlerWithin bounds one handler
+and HandleWithin a whole Handle call. A handler still running at the deadline is
+abandoned on its goroutine and recorded as a HandlerTimeoutException, and the chain
+proceeds to Finally:
+
+	Try(serve).HandleWithin(50*time.Millisecond,
+	    Handler[NetworkException](reportToUpstream),
+	).Finally(release)
+
+# Degraded Outcomes
+
+Handlers can record that they recovered with reduced functionality. Callers query it
+and observers receive an EventDegraded:
+
+	tr := Try(loadRecommendations).Handle(
+	    Handler[NetworkException](func(ex NetworkException, full Exception) {
+	        useDefaults()
+	        full.Degraded("served default recommendations")
+	    }),
+	)
+	if tr.IsDegraded() { ... }
+
+# Try Reports
+
+Report returns the structured outcome of a Try (succeeded, handled, unhandled or
+degraded), its duration, the handler that matched and a redacted exception summary:
+
+	report := Try(transferFunds, WithName("transfer")).Handle(handlers...).Report()
+	audit.Log(report.Name, report.Outcome.String(), report.Handler, report.Duration)
+
+In debug mode, WithLeakCheck adds goroutine and allocation deltas to the report
+and flags blocks that threw while leaving goroutines running:
+
+	SetDebugMode(true)
+	report := Try(startWorkers, WithLeakCheck()).Any(logIt).Report()
+	if report.LeakSuspected { t.Errorf("leaked %d goroutines", report.Resources.Goroutines) }
+
+Report.Score and ScoreFor rate an exception from 0 to 100 for alert routing,
+weighing its log level, SLO category, frequency, blame (caller, dependency or
+service, see BlameFor) and whether it was handled. SetScorer replaces the weights
+(see WeightedScorer) or the whole scoring:
+
+	if report.Score >= 70 { pager.Trigger(report) }
+
+Exception types can document themselves. RegisterDoc attaches a description and a
+remediation hint, shown in report summaries and written out by WriteDocs:
+
+	RegisterDoc[TimeoutException]("a downstream call missed its deadline", "check downstream latency")
+	WriteDocs(runbook) // one Markdown section per documented type
+
+# Try Options
+
+Per-call behaviour is configured with options instead of Try variants:
+
+	Try(func() {
+	    importBatch(ctx, batch)
+	}, WithName("import"), WithContext(ctx), WithoutStack(), WithObserver(auditObserver))
+
+Named Trys run with the pprof label "operation" set, and their handlers also carry
+"exception_type", so CPU and heap profiles can be sliced by operation and by
+whether the path was exceptional.
+
+# Idempotency Keys
+
+Retried operations can share an idempotency key. With a duplicate window configured,
+repeated events for the same key and fingerprint are suppressed but counted, and the
+next delivered event reports the total in Event.Occurrences:
+
+	SetDuplicateWindow(time.Minute)
+	Try(chargeCard, WithIdempotencyKey(order.ID)).End()
+
+# Tenants
+
+Try scopes can be tagged with a tenant, explicitly or through a context extractor.
+Duplicate suppression is partitioned per tenant, and a per-tenant budget keeps one
+noisy tenant from flooding reporters:
+
+	SetTenantExtractor(func(ctx context.Context) string { return tenantFrom(ctx) })
+	SetTenantBudget(100, time.Minute)
+
+	Try(handleRequest, WithContext(r.Context()))
+
+# Stats and Burst Detection
+
+Every exception caught by Try is counted per fingerprint (see Stats), and per
+tenant, operation name, type and fingerprint with a rate over a sliding window
+(see StatsByOperation, TenantStatsByOperation and SetStatsWindow). A burst detector compares each fingerprint's
+rate to its learned baseline and calls back when it spikes, before external
+monitoring catches up:
+
+	stop := DetectBursts(BurstDetector{
+	    Window:   time.Minute,
+	    Multiple: 5,
+	    MinCount: 20,
+	    OnBurst:  func(b Burst) { alert(b.TypeName, b.Count, b.Baseline) },
+	})
+	defer stop()
+
+The most recent exceptions are kept in a bounded history (see SetHistoryLimit).
+RangeHistory and RangeStats iterate stable snapshots, so debug endpoints and tests
+can read telemetry while other goroutines keep throwing:
+
+	RangeHistory(func(entry HistoryEntry) bool {
+	    fmt.Fprintln(w, entry.Time, entry.Exception.Error())
+	    return true
+	})
+
+Handlers are counted too, with a latency histogram per handler (see StatsByHandler).
+SetSlowHandlerThreshold reports handlers whose p99 exceeds a threshold to observers
+as a SlowHandlerException, since recovery code that slowly gets slower is easy to miss:
+
+	SetSlowHandlerThreshold(50 * time.Millisecond)
+
+# Observers and Policies
+
+Observers are notified when Try captures an exception, when a handler consumes it,
+and when a chain ends (Finally, End, Rethrow) without handling it. Per-type policies
+classify exceptions operationally, for example by the SLO they burn:
+
+	RegisterPolicy[NetworkException](ExceptionPolicy{SLO: SLOAvailability})
+
+	remove := AddObserver(SLOObserver(func(burn SLOBurnEvent) {
+	    metrics.Inc("slo_burn", burn.Category.String(), burn.Handled)
+	}))
+	defer remove()
+
+New policies and translators can be validated in production first: their dry-run
+variants log the decision they would have made next to the one in effect, without
+changing behavior (see SetDryRunLogger):
+
+	RegisterPolicyDryRun[NetworkException](ExceptionPolicy{SLO: SLOLatency, Retryable: false})
+	RegisterTranslatorDryRun(translateDriverErrors)
+
+Observers run inline. NewAsyncObserver moves a slow one, such as a remote reporter,
+to a background goroutine behind a bounded queue with a drop policy; Close flushes it
+before exit:
+
+	async := NewAsyncObserver(sentryObserver, AsyncConfig{QueueSize: 4096, Drop: DropOldest})
+	AddObserver(async)
+	defer async.Close(shutdownCtx)
+
+TraceObserver records unhandled exceptions, and a sampled fraction of handled ones,
+as span events in the span of the Try's context. It stays free of tracing
+dependencies: the AddEvent callback bridges to OpenTelemetry or any other tracer.
+
+	AddObserver(TraceObserver(TraceConfig{HandledFraction: 0.01, AddEvent: addSpanEvent}))
+
+Report sends an exception to observers as an EventWarning without throwing it, for
+problems worth structured reporting that should not interrupt the caller:
+
+	Report(ConfigException{Key: "timeout", Message: "invalid value, using default"}, WithName("config.load"))
+
+# Structured Logging
+
+SlogObserver logs handled and unhandled exceptions through log/slog. The level is
+chosen per type and outcome, so expected validation failures stay out of
+error-level logs: handled ArgumentNullException logs at Debug, unhandled at Error.
+
+	AddObserver(SlogObserver(slog.Default()))
+	RegisterLogLevels[NotFoundException](LogLevels{Handled: slog.LevelDebug, Unhandled: slog.LevelWarn})
+
+NewExceptionLogHandler wraps any slog.Handler so that exceptions passed as log
+attributes are expanded into type, message, fingerprint, code, origin and tenant
+fields, nested under the attribute key or a fixed group:
+
+	logger := slog.New(NewExceptionLogHandler(handler, ExceptionLogOptions{}))
+	logger.Error("checkout failed", "err", full) // err.type=..., err.fingerprint=...
+
+Without it, exceptions are slog.LogValuers logged as a group with their redacted
+data, stack and inner chain. ExceptionAttr builds the attribute, and LogException
+logs an exception on its own:
+
+	logger.Error("checkout failed", ExceptionAttr("err", &full))
+	LogException(logger, &full, "job", name)
+
+Handlers that only log and swallow the exception are one line, with the fields of
+SlogObserver:
+
+	CatchLogged[NetworkException](Try(sync), logger, "sync skipped").End()
+	Try(sync).Handle(HandlerLogged[NetworkException](logger, slog.LevelWarn))
+
+Acknowledged failures can be registered as known issues for a while. Their
+exceptions are logged at Info at most and carry the ticket in logs and reports:
+
+	RegisterKnownIssue(full.Fingerprint(), "PAY-1432", 7*24*time.Hour)
+
+# CloudEvents
+
+ToCloudEvent converts an exception to a CloudEvents 1.0 event for event buses. The
+type is built from the exception's Code field or TypeName, and the data holds the
+chain with redacted Data:
+
+	event, err := ToCloudEvent(&full, "/services/checkout")
+	payload, err := json.Marshal(event) // "type": "goexceptions.card_declined", ...
+
+# Retries
+
+Retry repeats an operation while it throws retryable exceptions, with exponential
+backoff. When it gives up, the RetryExhaustedException it throws carries the
+timeline of every attempt, also included in reports:
+
+	Try(func() {
+	    Retry(syncInventory, WithMaxAttempts(5), WithRetryName("sync"))
+	}).Any(func(ex Exception) {
+	    for _, attempt := range ex.Attempts() {
+	        log.Printf("#%d at %v took %v: %s", attempt.Number, attempt.Start, attempt.Duration, attempt.Exception.Message)
+	    }
+	})
+
+# Circuit Breakers
+
+A CircuitBreaker stops calling a dependency after a run of failures of chosen
+types, throwing CircuitOpenException until a trial call after the cooldown
+succeeds. Exceptions of other types, such as validation failures, never open it:
+
+	payments := NewCircuitBreaker("payments",
+	    TripOn[NetworkException](), TripOn[TimeoutException](),
+	    WithTripThreshold(5, time.Minute), WithCooldown(30*time.Second))
+
+	payments.Try(charge).Handle(Handler[CircuitOpenException](queueForLater))
+
+# Fallback Chains
+
+Fallbacks tries a primary source and moves to the next alternative whenever a
+retryable exception is thrown (NetworkException by default, or any type whose
+ExceptionPolicy sets Retryable). If every source fails an AggregateException is thrown:
+
+	result := Fallbacks(queryPrimary, queryReplica, readCache)
+	log.Printf("served by source %d after %d failures", result.Source, len(result.Failures))
+
+Fallback declares a recovery value or alternate operation for chosen exception
+types, around a primary that may itself use Retry or a CircuitBreaker:
+
+	quote := Fallback(fetchQuote, cachedQuote, FallbackOn[CircuitOpenException]())
+
+Wrap composes these policies, the first one outermost, around a single block. Each
+layer lets the exceptions it does not absorb through unchanged, so the block's
+exception stays in the chain:
+
+	Wrap(FallbackPolicy(serveCached), RetryPolicy(), breaker, TimeoutPolicy(time.Second)).
+	    Try(fetchPrices).Any(logIt).End()
+
+# Scheduled Jobs
+
+Schedule runs a periodic task inside a Try. Failed runs reach observers as unhandled
+exceptions, repeated failures can skip runs, and a job can disable itself after a
+streak of failures:
+
+	job := Schedule(time.Minute, syncFeeds,
+	    WithJobName("feed-sync"), WithSkipAfter(3), WithDisableAfter(10))
+	defer job.Stop()
+
+# Batch File Processing
+
+ProcessFiles runs a function for each path in its own Try, records failures as
+FileException per path, reports progress and tells what is left to retry:
+
+	result := ProcessFiles(paths, importFile, WithFileProgress(showProgress))
+	if result.HasFailures() {
+	    saveForLater(result.ResumePaths())
+	}
+
+Batch HTTP endpoints can answer with a multi-status payload listing the status, code
+and message of every item. MultiStatusFromAggregate builds it from an
+AggregateException, identifying items by ItemIDKey:
+
+	WriteMultiStatus(w, result.MultiStatus())
+	WriteMultiStatus(w, MultiStatusFromAggregate(&full))
+
+Streamed responses cannot change their status once started. StreamResponse ends an
+interrupted stream with a {"error": {...}} line and X-Stream-Status and
+X-Stream-Error trailers, so clients tell a failed stream from a complete one:
+
+	StreamResponse(w, writeRows).Any(logIt).End()
+
+# Lifecycle
+
+Lifecycle runs named startup and shutdown phases. A failing start phase aborts startup
+with a LifecycleException naming the phase; stop phases run in reverse and their
+failures are aggregated:
+
+	lc := NewLifecycle().
+	    OnStart("database", openDatabase).
+	    OnStop("database", closeDatabase)
+
+	lc.Start() // throws LifecycleException if any phase throws
+	defer lc.Stop()
+
+Before the process exits, Shutdown stops scheduled jobs, waits for abandoned blocks,
+cleanups and handlers to report, and flushes async observers, so exceptions raised in the last
+seconds of a pod's life are not lost:
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	Shutdown(ctx)
+
 # Native Panic Interception
 
 Automatically converts Go panics to exceptions:
@@ -177,14 +833,54 @@ Automatically converts Go panics to exceptions:
 	    log.Printf("Caught panic as exception: %s", ex.Error())
 	})
 
+Runtime panics are classified by their message (see ParsePanic) into
+IndexOutOfRangeException, NilReferenceException, DivideByZeroException and
+TypeAssertionException, with the index, length, address or types as fields.
+Other values become InvalidOperationException.
+
+Frameworks that rely on specific panic values keep working when those values are
+registered for passthrough. Try leaves them unconverted and panics again with them
+at the first handler call, or when the chain ends, after Finally:
+
+	RegisterPassthrough(http.ErrAbortHandler)
+	RegisterPassthroughType[testingSignal]()
+
+Plugin boundaries are where uncontrolled panics most often enter a process.
+CallPlugin calls a looked-up symbol through reflection, throwing
+PluginArgumentException for arguments the symbol cannot take, and wrapping any panic
+of the plugin in a PluginException:
+
+	sym, _ := p.Lookup("Transform")
+	out := CallPlugin(reflect.ValueOf(sym), input)[0].(string)
+
 # Performance
 
 Optimized for production use:
-- Reflection caching for type operations
+- Reflection caching for type operations, with Preload to warm the caches at startup
 - Minimal allocation overhead
 - Efficient stack trace capture
 - Benchmarked and tested
 
+Latency-sensitive services can prime the caches for known types at startup:
+
+	Preload[ArgumentNullException]()
+	PreloadTypes(NetworkException{}, FileException{})
+
+The type cache is bounded, 4096 entries by default, and evicts the matches least
+recently used first; preloaded ones are never evicted. Hosts handling very many
+exception types, such as plugin hosts, can watch TypeCacheStats and resize it:
+
+	SetTypeCacheLimit(16384)
+
+Paths throwing millions of expected exceptions should sample stacks rather than
+recycle exceptions. Capturing the stack trace is most of the time and allocations
+of a throw, and panic boxes the Exception whatever memory it came from, so an
+arena of recycled exceptions was measured slower than the default allocator and is
+not offered. BenchmarkThrowFullStacks, BenchmarkThrowSampledStacks and
+BenchmarkThrowWithoutStacks reproduce the numbers:
+
+	SetStackSampling(1000)
+
 # Testing
 
 Comprehensive test suite with 97.2% code coverage:
@@ -193,6 +889,59 @@ Comprehensive test suite with 97.2% code coverage:
 - Benchmark tests for performance validation
 - Edge case and error condition testing
 
+The exceptiontest subpackage helps testing code built on this package. VerifyContract
+fails a test when an exception outside a module's declared error surface escapes:
+
+	exceptiontest.VerifyContract(t, []string{"ArgumentNullException", "NetworkException"}, func() {
+	    client.Fetch(input)
+	})
+
+DiffExceptions compares two exceptions on what callers depend on: the type at each
+depth of the chain, the chain's shape, Code fields and Data keys. AssertNoDrift turns
+the report into test failures, to pin the error behavior of a recorded failure:
+
+	exceptiontest.AssertNoDrift(t, recorded, Try(checkout).GetException(), DiffDataKeys)
+
+Intercept observes exceptions of a type caught anywhere while a test runs, even
+those the code under test handles itself, and is removed when the test ends:
+
+	exceptiontest.Intercept(t, func(ex TimeoutException, full Exception) {
+	    timeouts++
+	})
+
+Libraries publishing custom exception types can certify them with
+RunTypeConformance. It checks Error and TypeName, registration without a TypeName
+collision, JSON and gob round trips, and catching by handlers, names and errors.As:
+
+	exceptiontest.RunTypeConformance(t, LedgerException{Account: "A-9", Message: "out of balance"})
+
+# Fault Injection
+
+InjectFault marks a point where staging should see failures. Builds with the
+goexceptions_faults tag enforce the fault matrix of the GOEXCEPTIONS_FAULTS variable,
+rules of type, probability and targeted points; in other builds it does nothing:
+
+	InjectFault("store.get")
+
+	go build -tags goexceptions_faults && GOEXCEPTIONS_FAULTS="TimeoutException:0.05@store.*|db.*" ./server
+
+A FaultInjector built with NewFaultInjector enforces its rules in any build.
+
+# Throw Site Index
+
+cmd/throwindex lists every Throw call of a module as JSON (file, line, enclosing
+function, exception type, message), for audits that should not need to run code:
+
+	go run github.com/bencz/go-exceptions/cmd/throwindex -type TimeoutException ./...
+
+Exception types being retired are marked with Deprecate. Each site still throwing
+them reports a DeprecatedThrowException warning to observers once, and throwindex
+flags the sites it finds, with their replacements:
+
+	Deprecate[LegacyQuotaException](QuotaExceededException{})
+
+	go run github.com/bencz/go-exceptions/cmd/throwindex -deprecated ./...
+
 # Thread Safety
 
 All operations are thread-safe and can be used in concurrent environments.
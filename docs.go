@@ -0,0 +1,101 @@
+package goexceptions
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// ============================================================================
+// EXCEPTION DOCS: Descriptions and remediation hints available at runtime
+// ============================================================================
+
+// ExceptionDoc describes an exception type for operators
+type ExceptionDoc struct {
+	TypeName    string
+	Description string // what the exception means
+	Remediation string // what to check or do when it occurs
+}
+
+var docsMutex sync.RWMutex
+var docs = make(map[reflect.Type]ExceptionDoc)
+
+// RegisterDoc attaches a description and a remediation hint to exceptions of type T.
+// They appear in reports (see ExceptionSummary) and in the documentation written by
+// WriteDocs:
+//
+//	RegisterDoc[TimeoutException]("a downstream call missed its deadline", "check downstream latency")
+func RegisterDoc[T ExceptionType](description, remediation string) {
+	checkTypeNameOf[T]()
+	docsMutex.Lock()
+	defer docsMutex.Unlock()
+	docs[getTypeOf[T]()] = ExceptionDoc{
+		TypeName:    TypeNameOf[T](),
+		Description: description,
+		Remediation: remediation,
+	}
+}
+
+// UnregisterDoc removes the documentation of exceptions of type T
+func UnregisterDoc[T ExceptionType]() {
+	docsMutex.Lock()
+	defer docsMutex.Unlock()
+	delete(docs, getTypeOf[T]())
+}
+
+// DocFor returns the documentation registered for the type of ex, or for the
+// nearest parent type it embeds (see BaseException)
+func DocFor(ex *Exception) (ExceptionDoc, bool) {
+	if ex == nil || ex.Type == nil {
+		return ExceptionDoc{}, false
+	}
+	exceptionType := reflect.TypeOf(ex.Type)
+
+	docsMutex.RLock()
+	defer docsMutex.RUnlock()
+	if doc, exists := docs[exceptionType]; exists {
+		return doc, true
+	}
+	for _, parent := range parentTypes(exceptionType) {
+		if doc, exists := docs[parent]; exists {
+			return doc, true
+		}
+	}
+	return ExceptionDoc{}, false
+}
+
+// Docs returns the registered documentation sorted by TypeName
+func Docs() []ExceptionDoc {
+	docsMutex.RLock()
+	all := make([]ExceptionDoc, 0, len(docs))
+	for _, doc := range docs {
+		all = append(all, doc)
+	}
+	docsMutex.RUnlock()
+
+	sort.Slice(all, func(i, j int) bool { return all[i].TypeName < all[j].TypeName })
+	return all
+}
+
+// WriteDocs writes the registered documentation as Markdown, one section per type,
+// for generated runbooks
+func WriteDocs(w io.Writer) error {
+	for _, doc := range Docs() {
+		if _, err := fmt.Fprintf(w, "## %s\n\n", doc.TypeName); err != nil {
+			return err
+		}
+		if doc.Description != "" {
+			if _, err := fmt.Fprintf(w, "%s\n\n", doc.Description); err != nil {
+				return err
+			}
+		}
+		if doc.Remediation != "" {
+			if _, err := fmt.Fprintf(w, "**Remediation:** %s\n\n", doc.Remediation); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
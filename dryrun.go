@@ -0,0 +1,131 @@
+package goexceptions
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// DRY RUN: Validate new policies and translators without enforcing them
+// ============================================================================
+
+var dryRunMutex sync.RWMutex
+var dryRunLogger *slog.Logger
+var dryRunPolicies = make(map[reflect.Type]ExceptionPolicy)
+var dryRunTranslators []*translatorEntry
+
+// SetDryRunLogger sets where dry-run decisions are logged, slog.Default() if nil
+func SetDryRunLogger(logger *slog.Logger) {
+	dryRunMutex.Lock()
+	defer dryRunMutex.Unlock()
+	dryRunLogger = logger
+}
+
+// RegisterPolicyDryRun evaluates policy for exceptions of type T without enforcing
+// it: every time Try catches such an exception, the policy in effect and the
+// proposed one are logged. It returns a function that removes the dry run; promote
+// the policy with RegisterPolicy once validated.
+func RegisterPolicyDryRun[T ExceptionType](policy ExceptionPolicy) (remove func()) {
+	exceptionType := getTypeOf[T]()
+	checkTypeNameOf[T]()
+
+	dryRunMutex.Lock()
+	dryRunPolicies[exceptionType] = policy
+	dryRunMutex.Unlock()
+
+	return func() {
+		dryRunMutex.Lock()
+		defer dryRunMutex.Unlock()
+		if current, exists := dryRunPolicies[exceptionType]; exists && current == policy {
+			delete(dryRunPolicies, exceptionType)
+		}
+	}
+}
+
+// RegisterTranslatorDryRun evaluates translator on every error translated by
+// TranslateError without using its result: when it recognizes an error, the mapping
+// it would choose and the one actually used are logged. It returns a function that
+// removes the dry run; promote the translator with RegisterTranslator once validated.
+func RegisterTranslatorDryRun(translator Translator) (remove func()) {
+	entry := &translatorEntry{translate: translator}
+
+	dryRunMutex.Lock()
+	dryRunTranslators = append(dryRunTranslators, entry)
+	dryRunMutex.Unlock()
+
+	return func() {
+		dryRunMutex.Lock()
+		defer dryRunMutex.Unlock()
+		for i, existing := range dryRunTranslators {
+			if existing == entry {
+				dryRunTranslators = append(dryRunTranslators[:i:i], dryRunTranslators[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// dryRunPolicy logs the decision of a dry-run policy for a caught exception
+func (tr *TryResult) dryRunPolicy() {
+	dryRunMutex.RLock()
+	proposed, exists := dryRunPolicies[reflect.TypeOf(tr.exception.Type)]
+	logger := dryRunLogger
+	dryRunMutex.RUnlock()
+	if !exists {
+		return
+	}
+
+	current := tr.Policy()
+	logDryRun(logger, "dry-run policy",
+		slog.String("exception.type", tr.exception.TypeName()),
+		slog.String("operation", tr.config.name),
+		slog.Bool("changed", current != proposed),
+		slog.String("current.slo", current.SLO.String()),
+		slog.Bool("current.retryable", current.Retryable),
+		slog.String("proposed.slo", proposed.SLO.String()),
+		slog.Bool("proposed.retryable", proposed.Retryable),
+	)
+}
+
+// dryRunTranslate logs what the dry-run translators would map err to
+func dryRunTranslate(err error, current ExceptionType) {
+	dryRunMutex.RLock()
+	entries := dryRunTranslators
+	logger := dryRunLogger
+	dryRunMutex.RUnlock()
+
+	for _, entry := range entries {
+		proposed, ok := callDryRunTranslator(entry.translate, err)
+		if !ok {
+			continue
+		}
+		logDryRun(logger, "dry-run translator",
+			slog.String("error", err.Error()),
+			slog.Bool("changed", reflect.TypeOf(proposed) != reflect.TypeOf(current)),
+			slog.String("current.type", current.TypeName()),
+			slog.String("proposed.type", proposed.TypeName()),
+		)
+		return
+	}
+}
+
+// callDryRunTranslator keeps a faulty translator under evaluation from breaking
+// the translation in effect
+func callDryRunTranslator(translate Translator, err error) (ex ExceptionType, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ex, ok = nil, false
+		}
+	}()
+	ex, ok = translate(err)
+	return ex, ok && ex != nil
+}
+
+func logDryRun(logger *slog.Logger, msg string, attrs ...slog.Attr) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.LogAttrs(context.Background(), slog.LevelInfo, msg, attrs...)
+}
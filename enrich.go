@@ -0,0 +1,60 @@
+package goexceptions
+
+// ============================================================================
+// ENRICHERS: Annotate exceptions before handlers see them
+// ============================================================================
+
+// enricher annotates exceptions without catching them
+type enricher struct {
+	enrich func(ex *Exception)
+}
+
+// Handle annotates a copy of ex, sharing its Data, and never claims it. Handle and
+// HandleWithin call the enrichment on the captured exception itself instead.
+func (e *enricher) Handle(ex Exception) bool {
+	e.enrich(&ex)
+	return false
+}
+
+// Matches reports that an enricher catches nothing
+func (e *enricher) Matches(ex Exception) bool {
+	return false
+}
+
+// HandlerName describes the enricher in reports
+func (e *enricher) HandlerName() string {
+	return "Enricher"
+}
+
+// Enricher returns a handler that annotates the exception without consuming it, for
+// cross-cutting enrichment such as request data or tags. Wherever it appears among
+// the handlers of Handle or HandleWithin, it runs first, on the captured exception,
+// so the matching handler, observers and reports see the annotations. A panicking
+// enricher is reported with EventHandlerFailed and the other handlers still run:
+//
+//	withRequest := Enricher(func(ex *Exception) {
+//	    ex.Data["request_id"] = requestID(r)
+//	})
+//	Try(serve).Handle(withRequest, Handler[NotFoundException](notFound), HandlerAny(fail))
+func Enricher(enrich func(ex *Exception)) ExceptionHandler {
+	return &enricher{enrich: enrich}
+}
+
+func isEnricher(handler ExceptionHandler) bool {
+	_, ok := handler.(*enricher)
+	return ok
+}
+
+// runEnrichers runs the enrichers among handlers, in order, on the captured exception
+func (tr *TryResult) runEnrichers(handlers []ExceptionHandler) {
+	for _, handler := range handlers {
+		e, ok := handler.(*enricher)
+		if !ok {
+			continue
+		}
+		by := handlerRef{handler: e}
+		if failure := tr.callHandler(by, func() { e.enrich(tr.exception) }); failure != nil {
+			tr.emit(EventHandlerFailed, tr.handlerFailureOf(by, failure), "")
+		}
+	}
+}
@@ -0,0 +1,255 @@
+// Package exceptiontest provides test helpers for code built on goexceptions.
+package exceptiontest
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// ============================================================================
+// CONTRACT VERIFICATION: Enforce the documented error surface of a module
+// ============================================================================
+
+// ContractRuns is how many times VerifyContract runs a producer, so that
+// nondeterministic failure paths get a chance to surface
+var ContractRuns = 20
+
+// ContractCase is one named producer in a contract table
+type ContractCase struct {
+	Name    string
+	Produce func()
+}
+
+// VerifyContract runs producer ContractRuns times and fails the test if an exception
+// whose TypeName is not in declaredTypes escapes it. Producers that complete without
+// throwing, or throw a declared type, satisfy the contract.
+func VerifyContract(t testing.TB, declaredTypes []string, producer func()) {
+	t.Helper()
+
+	declared := make(map[string]bool, len(declaredTypes))
+	for _, name := range declaredTypes {
+		declared[name] = true
+	}
+
+	reported := make(map[string]bool)
+	for run := 0; run < ContractRuns; run++ {
+		ex := Try(producer).GetException()
+		if ex == nil || declared[ex.TypeName()] || reported[ex.TypeName()] {
+			continue
+		}
+		reported[ex.TypeName()] = true
+		t.Errorf("undeclared exception %s escaped on run %d (declared: %s): %s",
+			ex.TypeName(), run+1, strings.Join(declaredTypes, ", "), ex.Error())
+	}
+}
+
+// VerifyContractCases runs VerifyContract for every case as a subtest
+func VerifyContractCases(t *testing.T, declaredTypes []string, cases []ContractCase) {
+	t.Helper()
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			VerifyContract(t, declaredTypes, c.Produce)
+		})
+	}
+}
+
+// ============================================================================
+// DRIFT DETECTION: Catch unintended changes of error behavior
+// ============================================================================
+
+// AssertNoDrift fails the test for every difference DiffExceptions finds between a
+// recorded exception and the one produced now, except for the allowed kinds
+func AssertNoDrift(t testing.TB, old, new *Exception, allowed ...DiffKind) {
+	t.Helper()
+
+	for _, change := range DiffExceptions(old, new).Changes {
+		if slices.Contains(allowed, change.Kind) {
+			continue
+		}
+		t.Errorf("exception drift: %s", change)
+	}
+}
+
+// ============================================================================
+// INTERCEPTION: Observe exceptions thrown deep inside the code under test
+// ============================================================================
+
+// Intercept calls handler with every exception matching T, as Handler[T] would match
+// it, that a Try catches while the test runs, including exceptions the code under
+// test handles itself. The interceptor is removed when the test completes.
+//
+// Interceptors are observers, so they see exceptions caught by parallel tests too and
+// nothing while observers are disabled with SetEnabled. Report failures from handler
+// with t.Error rather than t.Fatal, since it runs inside the code under test.
+func Intercept[T ExceptionType](t testing.TB, handler func(T, Exception)) {
+	t.Helper()
+
+	typed := Handler[T](handler)
+	remove := AddObserver(ObserverFunc(func(event Event) {
+		if event.Kind == EventCaught && event.Exception != nil {
+			typed.Handle(*event.Exception)
+		}
+	}))
+	t.Cleanup(remove)
+}
+
+// ============================================================================
+// TYPE CONFORMANCE: Certify custom exception types
+// ============================================================================
+
+// conformanceCheck is one property RunTypeConformance verifies
+type conformanceCheck struct {
+	name  string
+	check func(t testing.TB)
+}
+
+// RunTypeConformance verifies, in one subtest per property, that the custom exception
+// type T behaves as goexceptions expects: Error and TypeName work on sample and on the
+// zero value, TypeName does not depend on field values, T registers with
+// RegisterExceptionType without a TypeName collision, exceptions of type T survive
+// JSON and gob round trips, and handlers, MatchNamedType and errors.As catch them.
+// sample should have its fields set, so the round trips cover them:
+//
+//	func TestLedgerExceptionConformance(t *testing.T) {
+//	    exceptiontest.RunTypeConformance(t, LedgerException{Account: "A-9", Message: "out of balance"})
+//	}
+//
+// It registers T, as a library publishing T should do in an init function.
+func RunTypeConformance[T ExceptionType](t *testing.T, sample T) {
+	t.Helper()
+	for _, c := range conformanceChecks(sample) {
+		t.Run(c.name, func(t *testing.T) {
+			c.check(t)
+		})
+	}
+}
+
+func conformanceChecks[T ExceptionType](sample T) []conformanceCheck {
+	throw := func() *Exception {
+		return Try(func() { Throw(sample) }).GetException()
+	}
+
+	return []conformanceCheck{
+		{"Error", func(t testing.TB) {
+			var message string
+			failure := Try(func() { message = sample.Error() }).GetException()
+			if failure == nil && message == "" {
+				t.Errorf("Error of %T is empty", sample)
+			}
+			if failure == nil {
+				failure = Try(func() { _ = zeroOf[T]().Error() }).GetException()
+			}
+			if failure != nil {
+				t.Errorf("Error of %T panicked: %s", sample, failure.Error())
+			}
+		}},
+		{"TypeName", func(t testing.TB) {
+			name, zero := typeNameOf(sample), typeNameOf(zeroOf[T]())
+			switch {
+			case name == "" || zero == "":
+				t.Errorf("TypeName of %T is empty or panicked", sample)
+			case name != zero:
+				t.Errorf("TypeName of %T depends on its fields: %q, %q for the zero value", sample, name, zero)
+			case slices.Contains(genericTypeNames, name) && reflect.TypeOf(sample).Name() != name:
+				t.Errorf("TypeName of %T is the generic %q: embed the base type directly or override TypeName", sample, name)
+			}
+		}},
+		{"Registration", func(t testing.TB) {
+			if failure := Try(RegisterExceptionType[T]).GetException(); failure != nil {
+				t.Errorf("RegisterExceptionType[%T] failed: %s", sample, failure.Error())
+				return
+			}
+			name := typeNameOf(sample)
+			for _, collision := range TypeNameCollisions() {
+				if collision.Name == name {
+					t.Errorf("%s", collision.Error())
+				}
+			}
+		}},
+		{"JSON", func(t testing.TB) {
+			Try(RegisterExceptionType[T])
+			ex := throw()
+			payload, err := json.Marshal(ex)
+			if err != nil {
+				t.Errorf("encoding %T to JSON failed: %v", sample, err)
+				return
+			}
+			var back Exception
+			if err := json.Unmarshal(payload, &back); err != nil {
+				t.Errorf("decoding %T from JSON failed: %v", sample, err)
+				return
+			}
+			assertSameException(t, "JSON", ex, &back)
+		}},
+		{"Gob", func(t testing.TB) {
+			Try(RegisterExceptionType[T])
+			ex := throw()
+			var buf bytes.Buffer
+			if err := SendException(gob.NewEncoder(&buf), ex); err != nil {
+				t.Errorf("encoding %T with gob failed: %v", sample, err)
+				return
+			}
+			back, err := ReceiveException(gob.NewDecoder(&buf))
+			if err != nil {
+				t.Errorf("decoding %T with gob failed: %v", sample, err)
+				return
+			}
+			assertSameException(t, "gob", ex, back)
+		}},
+		{"Handlers", func(t testing.TB) {
+			var caught bool
+			Try(func() { Throw(sample) }).Handle(Handler[T](func(T, Exception) { caught = true }))
+			if !caught {
+				t.Errorf("Handler[%T] does not catch %T", sample, sample)
+			}
+			matcher := HandlerMatch(MatchNamedType(typeNameOf(sample)), func(Exception) {})
+			if Try(func() { Throw(sample) }).Handle(matcher).Report().Outcome != OutcomeHandled {
+				t.Errorf("MatchNamedType(%q) does not catch %T", typeNameOf(sample), sample)
+			}
+			var target T
+			if err := Try(func() { Throw(sample) }).AsError(); !errors.As(err, &target) {
+				t.Errorf("errors.As does not find %T in %v", sample, err)
+			}
+		}},
+	}
+}
+
+// genericTypeNames are reported by types embedding a base type indirectly
+var genericTypeNames = []string{"SimpleException", "BaseException", "RemoteException"}
+
+// zeroOf returns the zero value of T, or a pointer to one for pointer types
+func zeroOf[T ExceptionType]() T {
+	var zero T
+	if t := reflect.TypeOf(&zero).Elem(); t.Kind() == reflect.Pointer {
+		return reflect.New(t.Elem()).Interface().(T)
+	}
+	return zero
+}
+
+// typeNameOf returns the TypeName exceptions of the type of sample report, or "" if
+// it panics
+func typeNameOf(sample ExceptionType) string {
+	var name string
+	Try(func() { Throw(sample) }).Any(func(ex Exception) { name = ex.TypeName() })
+	return name
+}
+
+func assertSameException(t testing.TB, transport string, sent, received *Exception) {
+	t.Helper()
+	switch {
+	case reflect.TypeOf(received.Type) != reflect.TypeOf(sent.Type):
+		t.Errorf("%s round trip turned %T into %T", transport, sent.Type, received.Type)
+	case received.TypeName() != sent.TypeName():
+		t.Errorf("%s round trip changed TypeName %q into %q", transport, sent.TypeName(), received.TypeName())
+	case received.Error() != sent.Error():
+		t.Errorf("%s round trip changed the message %q into %q", transport, sent.Error(), received.Error())
+	}
+}
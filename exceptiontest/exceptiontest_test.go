@@ -0,0 +1,192 @@
+package exceptiontest
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// recordingT captures failures instead of failing the real test
+type recordingT struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestVerifyContract(t *testing.T) {
+	declared := []string{"ArgumentNullException", "NetworkException"}
+
+	t.Run("Declared exceptions satisfy the contract", func(t *testing.T) {
+		rec := &recordingT{TB: t}
+		var calls int
+
+		VerifyContract(rec, declared, func() {
+			calls++
+			if calls%2 == 0 {
+				ThrowArgumentNull("id", "missing")
+			}
+			ThrowNetworkError("https://api", "unreachable", nil)
+		})
+
+		if len(rec.failures) != 0 {
+			t.Errorf("Expected no failures, got %v", rec.failures)
+		}
+		if calls != ContractRuns {
+			t.Errorf("Expected %d runs, got %d", ContractRuns, calls)
+		}
+	})
+
+	t.Run("Undeclared exceptions are reported once", func(t *testing.T) {
+		rec := &recordingT{TB: t}
+		var calls int
+
+		VerifyContract(rec, declared, func() {
+			calls++
+			if calls > 5 {
+				ThrowInvalidOperation("leaked implementation detail")
+			}
+		})
+
+		if len(rec.failures) != 1 {
+			t.Fatalf("Expected one failure, got %v", rec.failures)
+		}
+	})
+
+	t.Run("Raw panics are undeclared", func(t *testing.T) {
+		rec := &recordingT{TB: t}
+
+		VerifyContract(rec, declared, func() {
+			var m map[string]int
+			m["boom"] = 1
+		})
+
+		if len(rec.failures) != 1 {
+			t.Errorf("Expected the converted panic to break the contract, got %v", rec.failures)
+		}
+	})
+}
+
+func TestVerifyContractCases(t *testing.T) {
+	VerifyContractCases(t, []string{"ArgumentOutOfRangeException"}, []ContractCase{
+		{Name: "valid input", Produce: func() {}},
+		{Name: "negative input", Produce: func() {
+			ThrowArgumentOutOfRange("amount", -1, "must be positive")
+		}},
+	})
+}
+
+func TestAssertNoDrift(t *testing.T) {
+	capture := func(block func()) *Exception {
+		return Try(block).GetException()
+	}
+	old := capture(func() { ThrowArgumentNull("id", "missing") })
+
+	t.Run("Unchanged contract passes", func(t *testing.T) {
+		rec := &recordingT{TB: t}
+		AssertNoDrift(rec, old, capture(func() { ThrowArgumentNull("name", "empty") }))
+		if len(rec.failures) != 0 {
+			t.Errorf("Expected no failures, got %v", rec.failures)
+		}
+	})
+
+	t.Run("Unexpected drift fails, allowed kinds do not", func(t *testing.T) {
+		new := capture(func() { ThrowArgumentNull("id", "missing") })
+		new.Data["field"] = "id"
+
+		rec := &recordingT{TB: t}
+		AssertNoDrift(rec, old, new)
+		if len(rec.failures) != 1 {
+			t.Fatalf("Expected one failure, got %v", rec.failures)
+		}
+
+		rec = &recordingT{TB: t}
+		AssertNoDrift(rec, old, new, DiffDataKeys)
+		if len(rec.failures) != 0 {
+			t.Errorf("Allowed drift should pass, got %v", rec.failures)
+		}
+	})
+}
+
+func TestIntercept(t *testing.T) {
+	// deep handles its own exception, so nothing escapes to the test
+	deep := func() {
+		Try(func() {
+			ThrowArgumentNull("id", "missing")
+		}).Any(func(Exception) {})
+	}
+
+	var seen []string
+	t.Run("Intercepts handled exceptions", func(t *testing.T) {
+		Intercept(t, func(ex ArgumentNullException, full Exception) {
+			seen = append(seen, ex.ParamName)
+		})
+		Intercept(t, func(ex NetworkException, full Exception) {
+			t.Errorf("Unexpected interception of %s", full.Error())
+		})
+		deep()
+		if len(seen) != 1 || seen[0] != "id" {
+			t.Errorf("Expected the ArgumentNullException to be intercepted, got %v", seen)
+		}
+	})
+
+	deep()
+	if len(seen) != 1 {
+		t.Errorf("Interceptor should be removed with its test, got %v", seen)
+	}
+}
+
+type LedgerException struct {
+	SimpleException
+	Account string
+	Balance int
+}
+
+// ReconciliationException embeds SimpleException indirectly, so it reports the
+// generic TypeName
+type ReconciliationException struct {
+	LedgerException
+}
+
+// ShardException names itself after a field
+type ShardException struct {
+	Shard string
+}
+
+func (e ShardException) Error() string    { return "shard " + e.Shard + " unavailable" }
+func (e ShardException) TypeName() string { return e.Shard + "ShardException" }
+
+func TestRunTypeConformance(t *testing.T) {
+	RunTypeConformance(t, LedgerException{SimpleException: SimpleException{Message: "out of balance", Code: "ledger_balance"}, Account: "A-9", Balance: -40})
+
+	failedChecks := func(checks []conformanceCheck) []string {
+		var failed []string
+		for _, c := range checks {
+			rec := &recordingT{TB: t}
+			c.check(rec)
+			if len(rec.failures) > 0 {
+				failed = append(failed, c.name)
+			}
+		}
+		return failed
+	}
+
+	t.Run("Generic TypeNames are reported", func(t *testing.T) {
+		sample := ReconciliationException{LedgerException{Account: "A-9"}}
+		if failed := failedChecks(conformanceChecks(sample)); fmt.Sprint(failed) != "[TypeName]" {
+			t.Errorf("Failed checks %v, want TypeName only", failed)
+		}
+	})
+
+	t.Run("TypeNames depending on fields are reported", func(t *testing.T) {
+		sample := ShardException{Shard: "eu"}
+		if failed := failedChecks(conformanceChecks(sample)); fmt.Sprint(failed) != "[TypeName]" {
+			t.Errorf("Failed checks %v, want TypeName only", failed)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package goexceptions
+
+import "reflect"
+
+// ============================================================================
+// FALLBACK CHAINS: Primary source with ordered alternatives
+// ============================================================================
+
+// FallbackResult carries the value produced by a fallback chain and where it came from
+type FallbackResult[T any] struct {
+	Value    T
+	Source   int          // 0 for the primary, i for the i-th secondary
+	Failures []*Exception // retryable failures of the sources tried before Source
+}
+
+// FromPrimary reports whether the primary source served the result
+func (r FallbackResult[T]) FromPrimary() bool {
+	return r.Source == 0
+}
+
+// Fallbacks calls primary and, while the current source throws a retryable exception
+// (see IsRetryable), moves on to the next secondary in order. A non-retryable exception
+// is rethrown immediately. If every source fails, an AggregateException holding all
+// failures is thrown.
+func Fallbacks[T any](primary func() T, secondaries ...func() T) FallbackResult[T] {
+	sources := append([]func() T{primary}, secondaries...)
+
+	var failures []*Exception
+	for i, source := range sources {
+		var value T
+		ex := Try(func() {
+			value = source()
+		}).settle()
+
+		if ex == nil {
+			return FallbackResult[T]{Value: value, Source: i, Failures: failures}
+		}
+		if !IsRetryable(ex) {
+			panic(*ex)
+		}
+		failures = append(failures, ex)
+	}
+
+	Throw(AggregateException{Message: "all fallback sources failed", Exceptions: failures})
+	return FallbackResult[T]{}
+}
+
+// FallbackOption configures Fallback
+type FallbackOption func(*fallbackConfig)
+
+type fallbackConfig struct {
+	fallbackIf []func(ex *Exception) bool
+}
+
+// FallbackOn falls back on exceptions of type T, or of types implementing or
+// embedding it. It can be given several times.
+func FallbackOn[T ExceptionType]() FallbackOption {
+	return WithFallbackIf(func(ex *Exception) bool {
+		return isTypeMatch[T](reflect.TypeOf(ex.Type))
+	})
+}
+
+// WithFallbackIf falls back on the exceptions accepted by fallbackIf. It can be
+// combined with FallbackOn; an exception accepted by any of them falls back.
+func WithFallbackIf(fallbackIf func(ex *Exception) bool) FallbackOption {
+	return func(c *fallbackConfig) {
+		c.fallbackIf = append(c.fallbackIf, fallbackIf)
+	}
+}
+
+// Fallback returns what primary returns or, when it throws, what fallback returns
+// for the exception: a recovery value or the result of an alternate operation.
+// Without FallbackOn or WithFallbackIf every exception falls back; with them, other
+// exceptions are rethrown. Exceptions thrown by fallback propagate.
+//
+// Retry and CircuitBreaker compose inside primary, falling back once they give up:
+//
+//	quote := Fallback(func() Quote {
+//	    return RetryValue(func() (q Quote) {
+//	        pricing.Run(func() { q = fetchQuote(id) })
+//	        return q
+//	    })
+//	}, func(ex Exception) Quote {
+//	    return cachedQuote(id)
+//	}, FallbackOn[RetryExhaustedException](), FallbackOn[CircuitOpenException]())
+func Fallback[T any](primary func() T, fallback func(Exception) T, opts ...FallbackOption) T {
+	var config fallbackConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	var value T
+	ex := Try(func() {
+		value = primary()
+	}).settle()
+	if ex == nil {
+		return value
+	}
+	if !config.fallsBack(ex) {
+		panic(*ex)
+	}
+	return fallback(*ex)
+}
+
+func (c fallbackConfig) fallsBack(ex *Exception) bool {
+	if len(c.fallbackIf) == 0 {
+		return true
+	}
+	for _, fallbackIf := range c.fallbackIf {
+		if fallbackIf(ex) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+package goexceptions
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// FAULT INJECTION: Throw exceptions at named points to exercise handling paths
+// ============================================================================
+
+// EnvFaults is the environment variable holding the fault matrix of InjectFault
+const EnvFaults = "GOEXCEPTIONS_FAULTS"
+
+// FaultsCompiled reports whether InjectFault enforces the fault matrix, which it
+// only does in builds with the goexceptions_faults tag
+const FaultsCompiled = faultsCompiled
+
+// FaultPointKey holds the injection point in the Data of injected exceptions
+var FaultPointKey = DataKey[string]{Name: "fault_point"}
+
+// FaultRule throws an exception of a type at matching injection points with some
+// probability
+type FaultRule struct {
+	Type        string   // TypeName of the exception to throw
+	Probability float64  // chance of throwing at each matching point, from 0 to 1
+	Points      []string // injection points as path.Match patterns; none matches every point
+}
+
+func (r FaultRule) matches(point string) bool {
+	if len(r.Points) == 0 {
+		return true
+	}
+	for _, pattern := range r.Points {
+		if matched, _ := path.Match(pattern, point); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// InjectedFaultException is thrown for a rule whose Type is not a known exception
+// type. Known types are the built-in ones and those registered with Preload or
+// caught so far.
+type InjectedFaultException struct {
+	Point string
+	Type  string
+}
+
+func (e InjectedFaultException) Error() string {
+	return fmt.Sprintf("InjectedFaultException: %s injected at %s", e.Type, e.Point)
+}
+
+func (e InjectedFaultException) TypeName() string {
+	return "InjectedFaultException"
+}
+
+// ParseFaultMatrix parses a fault matrix such as
+//
+//	TimeoutException:0.05@db.*|cache.get; NetworkException:0.01
+//
+// made of rules separated by semicolons, each a type name, a probability and
+// optionally the injection points it targets, separated by |
+func ParseFaultMatrix(spec string) ([]FaultRule, error) {
+	var rules []FaultRule
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		entry, points, targeted := strings.Cut(entry, "@")
+		name, probability, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, fmt.Errorf("goexceptions: fault %q is not type:probability", entry)
+		}
+		rule := FaultRule{Type: strings.TrimSpace(name)}
+		p, err := strconv.ParseFloat(strings.TrimSpace(probability), 64)
+		if err != nil || p < 0 || p > 1 {
+			return nil, fmt.Errorf("goexceptions: invalid probability %q for %s", probability, rule.Type)
+		}
+		rule.Probability = p
+		if targeted {
+			for _, point := range strings.Split(points, "|") {
+				point = strings.TrimSpace(point)
+				if _, err := path.Match(point, ""); err != nil || point == "" {
+					return nil, fmt.Errorf("goexceptions: invalid injection point %q for %s", point, rule.Type)
+				}
+				rule.Points = append(rule.Points, point)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// FaultInjector throws exceptions at injection points following a fault matrix. It
+// is enforced wherever it is called; InjectFault uses one restricted to fault builds.
+type FaultInjector struct {
+	mutex sync.RWMutex
+	rules []FaultRule
+}
+
+// NewFaultInjector returns an injector enforcing rules
+func NewFaultInjector(rules ...FaultRule) *FaultInjector {
+	return &FaultInjector{rules: rules}
+}
+
+// SetRules replaces the fault matrix
+func (fi *FaultInjector) SetRules(rules ...FaultRule) {
+	fi.mutex.Lock()
+	fi.rules = rules
+	fi.mutex.Unlock()
+}
+
+// Rules returns the fault matrix
+func (fi *FaultInjector) Rules() []FaultRule {
+	fi.mutex.RLock()
+	defer fi.mutex.RUnlock()
+	return append([]FaultRule(nil), fi.rules...)
+}
+
+// Inject throws the exception of the first matching rule that fires, with the point
+// under FaultPointKey in its Data
+func (fi *FaultInjector) Inject(point string) {
+	fi.mutex.RLock()
+	rules := fi.rules
+	fi.mutex.RUnlock()
+
+	for _, rule := range rules {
+		if !rule.matches(point) || rule.Probability <= 0 || rand.Float64() >= rule.Probability {
+			continue
+		}
+		ex := newException(faultException(rule.Type, point), nil)
+		SetTyped(&ex, FaultPointKey, point)
+		panic(ex)
+	}
+}
+
+// faultException builds a zero exception of the named type, with its Message field
+// describing the fault
+func faultException(name, point string) ExceptionType {
+	t, known := exceptionTypeNamed(name)
+	if !known || t.Kind() != reflect.Struct {
+		return InjectedFaultException{Point: point, Type: name}
+	}
+
+	value := reflect.New(t).Elem()
+	if message := value.FieldByName("Message"); message.IsValid() && message.CanSet() && message.Kind() == reflect.String {
+		message.SetString("fault injected at " + point)
+	}
+	return value.Interface().(ExceptionType)
+}
+
+var faultMatrix = NewFaultInjector()
+var loadFaultsOnce sync.Once
+
+// InjectFault marks an injection point. In builds with the goexceptions_faults tag
+// it enforces the fault matrix of the GOEXCEPTIONS_FAULTS environment variable, or
+// the one set with ConfigureFaults, and in other builds it does nothing:
+//
+//	func (s *Store) Get(key string) []byte {
+//	    InjectFault("store.get")
+//	    ...
+//	}
+//
+//	go test -tags goexceptions_faults ./... # with GOEXCEPTIONS_FAULTS="IOException:0.1@store.*"
+func InjectFault(point string) {
+	if !faultsCompiled {
+		return
+	}
+	loadFaultsOnce.Do(loadFaultsFromEnv)
+	faultMatrix.Inject(point)
+}
+
+// ConfigureFaults replaces the fault matrix of InjectFault with one parsed by
+// ParseFaultMatrix. It returns an error in builds without the goexceptions_faults tag.
+func ConfigureFaults(spec string) error {
+	if !faultsCompiled {
+		return errors.New("goexceptions: fault injection is not compiled in (build with -tags goexceptions_faults)")
+	}
+	rules, err := ParseFaultMatrix(spec)
+	if err != nil {
+		return err
+	}
+	loadFaultsOnce.Do(func() {})
+	faultMatrix.SetRules(rules...)
+	return nil
+}
+
+func loadFaultsFromEnv() {
+	rules, err := ParseFaultMatrix(os.Getenv(EnvFaults))
+	if err != nil {
+		Report(InvalidOperationException{Message: err.Error()})
+		return
+	}
+	faultMatrix.SetRules(rules...)
+}
@@ -0,0 +1,5 @@
+//go:build goexceptions_faults
+
+package goexceptions
+
+const faultsCompiled = true
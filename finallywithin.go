@@ -0,0 +1,77 @@
+package goexceptions
+
+import (
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// TIME-BOXED CLEANUP: Finally that cannot hang the caller
+// ============================================================================
+
+// CleanupTimeoutException is reported to observers when a cleanup exceeds its deadline
+type CleanupTimeoutException struct {
+	Operation string
+	Timeout   time.Duration
+	Message   string
+}
+
+func (e CleanupTimeoutException) Error() string {
+	return fmt.Sprintf("CleanupTimeoutException: cleanup of '%s' exceeded %v. %s", e.Operation, e.Timeout, e.Message)
+}
+
+func (e CleanupTimeoutException) TypeName() string {
+	return "CleanupTimeoutException"
+}
+
+// FinallyWithin runs cleanup like Finally but waits at most d for it. If the deadline
+// passes, a CleanupTimeoutException is reported to observers and the caller continues
+// while cleanup keeps running in the background; a later panic of the abandoned
+// cleanup is reported to observers as well. A cleanup that panics within the deadline
+// panics in the caller, as with Finally.
+func (tr *TryResult) FinallyWithin(d time.Duration, cleanup func()) *TryResult {
+	if tr == nil {
+		return tr
+	}
+
+	done := make(chan any, 1)
+	go func() {
+		defer func() {
+			done <- recover()
+		}()
+		cleanup()
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		tr.completeStrict()
+		if r != nil {
+			panic(r)
+		}
+	case <-timer.C:
+		tr.emit(EventUnhandled, &Exception{
+			Type: CleanupTimeoutException{
+				Operation: tr.config.name,
+				Timeout:   d,
+				Message:   "cleanup abandoned, still running in background",
+			},
+			Origin: throwOrigin(),
+			Data:   make(map[string]interface{}),
+			Inner:  tr.exception,
+		}, "")
+		abandoned.add()
+		go tr.watchAbandonedCleanup(done)
+		tr.completeStrict()
+	}
+	return tr
+}
+
+func (tr *TryResult) watchAbandonedCleanup(done <-chan any) {
+	defer abandoned.done()
+	if r := <-done; r != nil {
+		tr.emit(EventUnhandled, exceptionFromPanic(r), "")
+	}
+}
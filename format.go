@@ -0,0 +1,70 @@
+package goexceptions
+
+import (
+	"fmt"
+	"io"
+)
+
+// ============================================================================
+// FORMATTING: fmt verbs as pkg/errors users expect them
+// ============================================================================
+
+// Format implements fmt.Formatter:
+//
+//	%s, %v  the message, as Error
+//	%q      the message, quoted
+//	%+v     the chain, each exception followed by its stack trace, or its origin
+//	        when the stack was sampled out
+//	%#v     a dump of the fields, with redacted data (see RedactedData)
+//
+// Chains are cut after 8 exceptions and values are bounded as by SafeValue.
+func (e Exception) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case s.Flag('+'):
+			e.formatChain(s)
+		case s.Flag('#'):
+			e.formatDump(s, 0)
+		default:
+			io.WriteString(s, e.Error())
+		}
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		fmt.Fprintf(s, "%%!%c(goexceptions.Exception=%s)", verb, e.Error())
+	}
+}
+
+func (e Exception) formatChain(w io.Writer) {
+	depth := 0
+	for current := &e; current != nil && depth < maxRenderDepth; current, depth = current.Inner, depth+1 {
+		if depth > 0 {
+			io.WriteString(w, "\ncaused by: ")
+		}
+		io.WriteString(w, current.Error())
+		frames := current.StackTrace
+		if len(frames) == 0 && current.Origin != "" {
+			frames = []string{current.Origin}
+		}
+		for _, frame := range frames {
+			io.WriteString(w, "\n\t"+frame)
+		}
+	}
+}
+
+func (e Exception) formatDump(w io.Writer, depth int) {
+	fmt.Fprintf(w, "goexceptions.Exception{Type:%#v, Origin:%q, StackTrace:%#v, Data:%#v, Inner:", SafeValue(e.Type), e.Origin, e.StackTrace, e.RedactedData())
+	switch {
+	case e.Inner == nil:
+		io.WriteString(w, "nil}")
+	case depth+1 >= maxRenderDepth:
+		io.WriteString(w, "&goexceptions.Exception{...}}")
+	default:
+		io.WriteString(w, "&")
+		e.Inner.formatDump(w, depth+1)
+		io.WriteString(w, "}")
+	}
+}
@@ -0,0 +1,160 @@
+package goexceptions
+
+import (
+	"bytes"
+	"encoding/gob"
+	"sync"
+)
+
+// ============================================================================
+// GOB TRANSPORT: Carry exceptions over encoding/gob and net/rpc
+// ============================================================================
+
+// RemoteError stands for an error received from another process
+type RemoteError struct {
+	Message string
+}
+
+func (e RemoteError) Error() string {
+	return e.Message
+}
+
+// exceptionWire is the gob representation of an Exception
+type exceptionWire struct {
+	Type       ExceptionType
+	StackTrace []string
+	Origin     string
+	Data       map[string]interface{}
+	Inner      *Exception
+	Cause      string
+}
+
+var registerGobOnce sync.Once
+
+func registerGobTypes() {
+	registerGobOnce.Do(func() {
+		gob.Register(RemoteError{})
+		gob.Register(map[string]interface{}{}) // bounded copies made by SafeValue
+		gob.Register([]interface{}{})
+		for _, sample := range builtinExceptionTypes {
+			gob.Register(sample)
+		}
+		gob.Register(RemoteException{})
+	})
+}
+
+// GobEncode implements gob.GobEncoder. With GobDecode, it lets an Exception be a
+// field of net/rpc replies or any gob message. The built-in exception types are
+// registered automatically; custom types must be registered by the application:
+//
+//	RegisterExceptionType[DatabaseException]()
+//
+// Error values do not survive the trip: the Cause of built-in types, and the
+// original error of translated exceptions, arrive as a RemoteError carrying the
+// message. Error fields of custom types must hold gob-registered types, such as
+// RemoteError. Data is sent as RedactedData gives it, so its values must be basic
+// types or registered as well.
+func (e Exception) GobEncode() ([]byte, error) {
+	registerGobTypes()
+	wire := exceptionWire{
+		Type:       gobSafeType(e.Type),
+		StackTrace: e.StackTrace,
+		Origin:     e.Origin,
+		Data:       e.RedactedData(),
+		Inner:      e.Inner,
+	}
+	if e.cause != nil {
+		wire.Cause = e.cause.Error()
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(wire); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder
+func (e *Exception) GobDecode(data []byte) error {
+	registerGobTypes()
+	var wire exceptionWire
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&wire); err != nil {
+		return err
+	}
+
+	*e = Exception{
+		Type:       bindException(wire.Type),
+		StackTrace: wire.StackTrace,
+		Origin:     wire.Origin,
+		Data:       wire.Data,
+		Inner:      wire.Inner,
+	}
+	if e.Data == nil {
+		e.Data = make(map[string]interface{})
+	}
+	if wire.Cause != "" {
+		e.cause = RemoteError{Message: wire.Cause}
+	}
+	return nil
+}
+
+// gobSafeType replaces the error causes of built-in types with RemoteError, and
+// bounds their free-form values with SafeValue
+func gobSafeType(exceptionType ExceptionType) ExceptionType {
+	switch e := exceptionType.(type) {
+	case ArgumentOutOfRangeException:
+		e.Value = SafeValue(e.Value)
+		return e
+	case ConcurrencyException:
+		e.ExpectedVersion = SafeValue(e.ExpectedVersion)
+		e.ActualVersion = SafeValue(e.ActualVersion)
+		return e
+	case FileException:
+		e.Cause = remoteError(e.Cause)
+		return e
+	case NetworkException:
+		e.Cause = remoteError(e.Cause)
+		return e
+	case IOException:
+		e.Cause = remoteError(e.Cause)
+		return e
+	case ParseException:
+		e.Cause = remoteError(e.Cause)
+		return e
+	case TemplateException:
+		e.Cause = remoteError(e.Cause)
+		return e
+	case WrappedErrorException:
+		e.Err = remoteError(e.Err)
+		return e
+	}
+	return exceptionType
+}
+
+func remoteError(err error) error {
+	switch err.(type) {
+	case nil, RemoteError:
+		return err
+	}
+	return RemoteError{Message: err.Error()}
+}
+
+// exceptionFrame lets a nil exception travel as "no exception"
+type exceptionFrame struct {
+	Exception *Exception
+}
+
+// SendException writes ex, which may be nil, to a gob stream
+func SendException(enc *gob.Encoder, ex *Exception) error {
+	return enc.Encode(exceptionFrame{Exception: ex})
+}
+
+// ReceiveException reads an exception written by SendException. It returns nil
+// without error when the sender had no exception.
+func ReceiveException(dec *gob.Decoder) (*Exception, error) {
+	var frame exceptionFrame
+	if err := dec.Decode(&frame); err != nil {
+		return nil, err
+	}
+	return frame.Exception, nil
+}
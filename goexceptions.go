@@ -5,7 +5,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
-	"sync"
+	"time"
 )
 
 // ExceptionType represents an exception type
@@ -28,6 +28,10 @@ func (e ArgumentNullException) TypeName() string {
 	return "ArgumentNullException"
 }
 
+func (e ArgumentNullException) ArgumentName() string {
+	return e.ParamName
+}
+
 // ArgumentOutOfRangeException ( comment to force new release... )
 type ArgumentOutOfRangeException struct {
 	ParamName string
@@ -36,13 +40,25 @@ type ArgumentOutOfRangeException struct {
 }
 
 func (e ArgumentOutOfRangeException) Error() string {
-	return fmt.Sprintf("ArgumentOutOfRangeException: Parameter '%s' with value '%v' is out of range. %s", e.ParamName, e.Value, e.Message)
+	return fmt.Sprintf("ArgumentOutOfRangeException: Parameter '%s' with value '%v' is out of range. %s", e.ParamName, formatSafe(e.Value), e.Message)
 }
 
 func (e ArgumentOutOfRangeException) TypeName() string {
 	return "ArgumentOutOfRangeException"
 }
 
+func (e ArgumentOutOfRangeException) ArgumentName() string {
+	return e.ParamName
+}
+
+// ArgumentException is implemented by the exceptions about an invalid argument, so
+// Handler[ArgumentException] catches ArgumentNullException and
+// ArgumentOutOfRangeException alike
+type ArgumentException interface {
+	ExceptionType
+	ArgumentName() string
+}
+
 type InvalidOperationException struct {
 	Message string
 }
@@ -93,8 +109,11 @@ func (e NetworkException) TypeName() string {
 type Exception struct {
 	Type       ExceptionType
 	StackTrace []string
+	Origin     string // throw site, kept even when the stack trace is sampled out
 	Data       map[string]interface{}
 	Inner      *Exception // support for nested exceptions
+	owner      *TryResult // Try that captured the exception
+	cause      error      // original error when translated from one
 }
 
 func (e Exception) Error() string {
@@ -105,13 +124,21 @@ func (e Exception) TypeName() string {
 	return e.Type.TypeName()
 }
 
+// cloneData returns a copy of data, for snapshots that later writes must not reach
+func cloneData(data map[string]interface{}) map[string]interface{} {
+	if data == nil {
+		return nil
+	}
+	clone := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		clone[key] = value
+	}
+	return clone
+}
+
 // Generic throw
 func Throw[T ExceptionType](exception T) {
-	panic(Exception{
-		Type:       exception,
-		StackTrace: getStackTrace(),
-		Data:       make(map[string]interface{}),
-	})
+	panic(newException(exception, nil))
 }
 
 // Helper throw functions
@@ -160,17 +187,17 @@ func ThrowIfNil(paramName string, value any) {
 
 // ThrowWithInner throws an exception with an inner exception
 func ThrowWithInner[T ExceptionType](exception T, inner *Exception) {
-	panic(Exception{
-		Type:       exception,
-		StackTrace: getStackTrace(),
-		Data:       make(map[string]interface{}),
-		Inner:      inner,
-	})
+	panic(newException(exception, inner))
 }
 
 func getStackTrace() []string {
+	return captureStackTrace(4)
+}
+
+// captureStackTrace formats up to 12 frames starting skip frames above itself
+func captureStackTrace(skip int) []string {
 	var traces []string
-	for i := 3; i < 15; i++ {
+	for i := skip; i < skip+12; i++ {
 		pc, file, line, ok := runtime.Caller(i)
 		if !ok {
 			break
@@ -197,88 +224,160 @@ func getStackTrace() []string {
 
 // TryResult with expandable system
 type TryResult struct {
-	exception *Exception
-	handled   bool
+	exception      *Exception
+	handled        bool
+	handledBy      handlerRef
+	handlerFailure *Exception
+	completed      bool
+	degradedReason string
+	duration       time.Duration
+	resources      *ResourceDelta
+	deferred       []func()
+	deferFailures  []*Exception
+	passthrough    any // panic value left untouched, see RegisterPassthrough
+	config         tryConfig
+}
+
+// newTryResult is the constructor of every TryResult, with its options applied.
+// Public entry points always return a result, so chain methods never see nil there.
+func newTryResult(opts []TryOption) *TryResult {
+	tr := &TryResult{}
+	for _, opt := range opts {
+		opt(&tr.config)
+	}
+	return tr
 }
 
-// Try executes a block that can throw exceptions
-func Try(tryBlock func()) *TryResult {
+// Try executes a block that can throw exceptions. Options (see TryOption) tune a
+// single call; without options no extra work is done.
+func Try(tryBlock func(), opts ...TryOption) *TryResult {
+	tr := newTryResult(opts)
+
 	var exception *Exception
+	probe := tr.startLeakCheck()
+	start := time.Now()
 
 	// Internal function to ensure defer is executed correctly
 	func() {
 		defer func() {
 			if r := recover(); r != nil {
-				switch e := r.(type) {
-				case Exception:
-					exception = &e
-				case ExceptionType:
-					exception = &Exception{
-						Type:       e,
-						StackTrace: getStackTrace(),
-						Data:       make(map[string]interface{}),
-					}
-				case error:
-					exception = &Exception{
-						Type:       InvalidOperationException{Message: e.Error()},
-						StackTrace: getStackTrace(),
-						Data:       make(map[string]interface{}),
-					}
-				default:
-					exception = &Exception{
-						Type:       InvalidOperationException{Message: fmt.Sprintf("%v", r)},
-						StackTrace: getStackTrace(),
-						Data:       make(map[string]interface{}),
-					}
+				if isPassthrough(r) {
+					tr.passthrough = r
+					return
 				}
+				exception = exceptionFromPanic(r)
 			}
 		}()
 
-		tryBlock()
+		tr.runLabeled(tryBlock)
 	}()
+	tr.duration = time.Since(start)
+	tr.finishLeakCheck(probe)
+
+	if exception != nil {
+		if exception.Origin == "" && len(exception.StackTrace) > 0 {
+			exception.Origin = exception.StackTrace[0]
+		}
+		if tr.config.noStack || stacksOff.Load() {
+			exception.StackTrace = nil
+		}
+		exception.owner = tr
+		tr.exception = exception
+		now := time.Now()
+		recordStats(exception, tr.Tenant(), tr.config.name, now)
+		if !historyOff.Load() {
+			recordHistory(tr, exception, now)
+		}
+		checkTypeName(reflect.TypeOf(exception.Type), exception.Type)
+		tr.dryRunPolicy()
+		tr.notify(EventCaught)
+	}
+
+	return tr
+}
 
-	return &TryResult{exception: exception}
+// exceptionFromPanic converts a recovered panic value into an exception. It must be
+// called from the deferred function that recovered, so the stack still shows the
+// panicking frames.
+func exceptionFromPanic(r any) *Exception {
+	var exception *Exception
+	switch e := r.(type) {
+	case Exception:
+		exception = &e
+	case ExceptionType:
+		exception = &Exception{
+			Type:       bindException(e),
+			StackTrace: getStackTrace(),
+			Data:       make(map[string]interface{}),
+		}
+		inheritData(exception)
+	case error:
+		exception = &Exception{
+			Type:       TranslateError(e),
+			StackTrace: getStackTrace(),
+			Data:       make(map[string]interface{}),
+			cause:      e,
+		}
+		if recorded := StackTraceOf(e); recorded != nil {
+			exception.StackTrace = recorded
+		}
+	default:
+		exceptionType, ok := ParsePanic(r)
+		if !ok {
+			exceptionType = InvalidOperationException{Message: fmt.Sprintf("%v", r)}
+		}
+		exception = &Exception{
+			Type:       exceptionType,
+			StackTrace: getStackTrace(),
+			Data:       make(map[string]interface{}),
+		}
+	}
+	return exception
 }
 
 // ============================================================================
 // PERFORMANCE: Type cache to avoid repeated reflection
 // ============================================================================
 
-var typeCache = make(map[reflect.Type]bool)
-var typeCacheMutex sync.RWMutex
-
 func getTypeOf[T any]() reflect.Type {
 	// Use reflect.TypeOf((*T)(nil)).Elem() to capture the correct type
 	// even when T is an interface
 	return reflect.TypeOf((*T)(nil)).Elem()
 }
 
+// isTypeMatch reports whether an exception of actualType is caught by a handler for
+// T: T is the same type, an interface the type implements, such as ArgumentException
+// or ExceptionType itself, or a parent type it embeds (see BaseException)
 func isTypeMatch[T any](actualType reflect.Type) bool {
-	expectedType := getTypeOf[T]()
-
-	// Cache lookup for performance
-	typeCacheMutex.RLock()
-	cacheKey := expectedType
-	if cached, exists := typeCache[cacheKey]; exists {
-		typeCacheMutex.RUnlock()
-		return cached && actualType == expectedType
+	if actualType == nil {
+		return false
 	}
-	typeCacheMutex.RUnlock()
+	return typeMatches(getTypeOf[T](), actualType)
+}
 
-	// Calculate and store in cache
-	match := actualType == expectedType
-	typeCacheMutex.Lock()
-	typeCache[cacheKey] = match
-	typeCacheMutex.Unlock()
+// typeMatches reports whether a handler of the expected type catches exceptions of
+// the actual type, through the type cache
+func typeMatches(expected, actual reflect.Type) bool {
+	key := typePair{expected: expected, actual: actual}
+	if match, cached := lookupTypeMatch(key); cached {
+		return match
+	}
 
+	match := computeTypeMatch(key)
+	storeTypeMatch(key, match)
 	return match
 }
 
+func computeTypeMatch(key typePair) bool {
+	return key.actual.AssignableTo(key.expected) || embedPath(key.actual, key.expected) != nil
+}
+
 // ============================================================================
 // APPROACH 1: Catch with Type Parameter using external function
 // ============================================================================
 
 func Catch[T ExceptionType](tr *TryResult, handler func(T, Exception)) *TryResult {
+	tr.passThrough()
 	if tr == nil || tr.exception == nil || tr.handled {
 		return tr
 	}
@@ -287,9 +386,10 @@ func Catch[T ExceptionType](tr *TryResult, handler func(T, Exception)) *TryResul
 	actualType := reflect.TypeOf(tr.exception.Type)
 
 	if isTypeMatch[T](actualType) {
-		exceptionValue := tr.exception.Type.(T)
-		handler(exceptionValue, *tr.exception)
-		tr.handled = true
+		exceptionValue, _ := exceptionAs[T](tr.exception.Type)
+		tr.runHandler(handlerRef{label: "Catch", typ: getTypeOf[T]()}, func() {
+			handler(exceptionValue, *tr.exception)
+		})
 	}
 
 	return tr
@@ -304,6 +404,7 @@ type CatchBuilder struct {
 }
 
 func (tr *TryResult) When() *CatchBuilder {
+	tr.passThrough()
 	return &CatchBuilder{result: tr}
 }
 
@@ -315,31 +416,56 @@ func On[T ExceptionType](cb *CatchBuilder, handler func(T, Exception)) *CatchBui
 	actualType := reflect.TypeOf(cb.result.exception.Type)
 
 	if isTypeMatch[T](actualType) {
-		exceptionValue := cb.result.exception.Type.(T)
-		handler(exceptionValue, *cb.result.exception)
-		cb.result.handled = true
+		exceptionValue, _ := exceptionAs[T](cb.result.exception.Type)
+		cb.result.runHandler(handlerRef{label: "On", typ: getTypeOf[T]()}, func() {
+			handler(exceptionValue, *cb.result.exception)
+		})
 	}
 
 	return cb
 }
 
-func (cb *CatchBuilder) Any(handler func(Exception)) *CatchBuilder {
+// Any registers a catch-all handler. It returns a CatchAllBuilder, which only offers
+// Finally and End: typed handlers registered after a catch-all would be unreachable,
+// so the type system rejects them.
+func (cb *CatchBuilder) Any(handler func(Exception)) *CatchAllBuilder {
 	if cb.result != nil && cb.result.exception != nil && !cb.result.handled {
-		handler(*cb.result.exception)
-		cb.result.handled = true
+		cb.result.runHandler(handlerRef{label: "Any"}, func() {
+			handler(*cb.result.exception)
+		})
 	}
-	return cb
+	return &CatchAllBuilder{result: cb.result}
 }
 
 func (cb *CatchBuilder) Finally(cleanup func()) *TryResult {
-	if cb.result != nil {
-		cleanup()
-	}
-	return cb.result
+	return finishBuilder(cb.result, cleanup)
 }
 
 func (cb *CatchBuilder) End() *TryResult {
-	return cb.result
+	return finishBuilder(cb.result, nil)
+}
+
+// CatchAllBuilder is the final stage of the builder, after a catch-all handler
+type CatchAllBuilder struct {
+	result *TryResult
+}
+
+func (cb *CatchAllBuilder) Finally(cleanup func()) *TryResult {
+	return finishBuilder(cb.result, cleanup)
+}
+
+func (cb *CatchAllBuilder) End() *TryResult {
+	return finishBuilder(cb.result, nil)
+}
+
+func finishBuilder(result *TryResult, cleanup func()) *TryResult {
+	if result != nil {
+		if cleanup != nil {
+			cleanup()
+		}
+		result.completeStrict()
+	}
+	return result
 }
 
 // ============================================================================
@@ -356,16 +482,24 @@ type TypedHandler[T ExceptionType] struct {
 }
 
 func (th *TypedHandler[T]) Handle(ex Exception) bool {
-	actualType := reflect.TypeOf(ex.Type)
-
-	if isTypeMatch[T](actualType) {
-		typedEx := ex.Type.(T)
+	if th.Matches(ex) {
+		typedEx, _ := exceptionAs[T](ex.Type)
 		th.handler(typedEx, ex)
 		return true
 	}
 	return false
 }
 
+// Matches reports whether the handler catches ex, without running it
+func (th *TypedHandler[T]) Matches(ex Exception) bool {
+	return isTypeMatch[T](reflect.TypeOf(ex.Type))
+}
+
+// HandlerName describes the handler in reports
+func (th *TypedHandler[T]) HandlerName() string {
+	return "Handler[" + getTypeOf[T]().Name() + "]"
+}
+
 func Handler[T ExceptionType](handler func(T, Exception)) ExceptionHandler {
 	return &TypedHandler[T]{handler: handler}
 }
@@ -380,19 +514,33 @@ func (gh *GenericHandler) Handle(ex Exception) bool {
 	return true
 }
 
+// Matches reports that the handler catches every exception
+func (gh *GenericHandler) Matches(ex Exception) bool {
+	return true
+}
+
+// HandlerName describes the handler in reports
+func (gh *GenericHandler) HandlerName() string {
+	return "HandlerAny"
+}
+
 // HandlerAny creates a generic handler that catches any exception
 func HandlerAny(handler func(Exception)) ExceptionHandler {
 	return &GenericHandler{handler: handler}
 }
 
 func (tr *TryResult) Handle(handlers ...ExceptionHandler) *TryResult {
+	tr.passThrough()
 	if tr == nil || tr.exception == nil || tr.handled {
 		return tr
 	}
 
+	tr.runEnrichers(handlers)
 	for _, handler := range handlers {
-		if handler.Handle(*tr.exception) {
-			tr.handled = true
+		if isEnricher(handler) {
+			continue
+		}
+		if tr.tryHandler(handlerRef{handler: handler}, handler) {
 			break
 		}
 	}
@@ -403,14 +551,39 @@ func (tr *TryResult) Handle(handlers ...ExceptionHandler) *TryResult {
 func (tr *TryResult) Finally(cleanup func()) *TryResult {
 	if tr != nil {
 		cleanup()
+		tr.completeStrict()
+	}
+	return tr
+}
+
+// End finishes a chain without cleanup, reporting the exception as unhandled if
+// no handler consumed it
+func (tr *TryResult) End() *TryResult {
+	if tr != nil {
+		tr.completeStrict()
 	}
 	return tr
 }
 
 func (tr *TryResult) Any(handler func(Exception)) *TryResult {
+	tr.passThrough()
 	if tr != nil && tr.exception != nil && !tr.handled {
-		handler(*tr.exception)
-		tr.handled = true
+		tr.runHandler(handlerRef{label: "Any"}, func() {
+			handler(*tr.exception)
+		})
+	}
+	return tr
+}
+
+// Else runs onSuccess when the block completed without an exception, so success-path
+// work stays in the chain. Exceptions thrown by onSuccess are not caught by the
+// chain's handlers:
+//
+//	Try(save).Else(func() { log.Print("saved") }).Any(logIt)
+func (tr *TryResult) Else(onSuccess func()) *TryResult {
+	tr.passThrough()
+	if tr != nil && tr.exception == nil {
+		onSuccess()
 	}
 	return tr
 }
@@ -428,11 +601,54 @@ func (tr *TryResult) GetException() *Exception {
 	return tr.exception
 }
 
-// Rethrow re-throws the exception if it wasn't handled
+// Rethrow re-throws the exception if it wasn't handled, and otherwise ends the chain.
+// The exception keeps its original stack trace and origin.
 func (tr *TryResult) Rethrow() {
-	if tr != nil && tr.exception != nil && !tr.handled {
-		panic(*tr.exception)
+	if tr == nil {
+		return
 	}
+	if tr.exception != nil && !tr.handled {
+		exception := *tr.exception
+		exception.owner = nil
+		tr.complete()
+		panic(exception)
+	}
+	tr.complete()
+}
+
+// RethrowAs is Rethrow throwing exception instead, with the unhandled exception as
+// its inner exception
+func (tr *TryResult) RethrowAs(exception ExceptionType) {
+	if tr == nil {
+		return
+	}
+	if tr.exception != nil && !tr.handled {
+		inner := *tr.exception
+		inner.owner = nil
+		tr.complete()
+		panic(newException(exception, &inner))
+	}
+	tr.complete()
+}
+
+// Rethrow throws the exception again, typically from a handler, keeping its original
+// stack trace and origin where Throw would record the handler as the throw site:
+//
+//	Handler[IOException](func(ex IOException, full Exception) {
+//	    if !recoverable(ex) {
+//	        full.Rethrow()
+//	    }
+//	})
+func (e Exception) Rethrow() {
+	e.owner = nil
+	panic(e)
+}
+
+// RethrowAs throws exception with e as its inner exception, so a handler can
+// translate a low-level exception without losing where it came from
+func (e Exception) RethrowAs(exception ExceptionType) {
+	e.owner = nil
+	panic(newException(exception, &e))
 }
 
 // ============================================================================
@@ -474,7 +690,7 @@ func FindInnerException[T ExceptionType](e *Exception) *T {
 	current := e
 	for current != nil {
 		if isTypeMatch[T](reflect.TypeOf(current.Type)) {
-			if typed, ok := current.Type.(T); ok {
+			if typed, ok := exceptionAs[T](current.Type); ok {
 				return &typed
 			}
 		}
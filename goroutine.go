@@ -0,0 +1,20 @@
+package goexceptions
+
+// ============================================================================
+// GOROUTINES: Exception-safe goroutine launcher
+// ============================================================================
+
+// Go runs fn in a new goroutine with its own exception capture. A panic there would
+// otherwise bypass every Try and crash the process; instead the exception is offered
+// to handlers, and reported to observers as EventUnhandled if none consumes it:
+//
+//	Go(func() { reindex(batch) }, Handler[IOException](func(ex IOException, full Exception) {
+//	    scheduleRetry(batch)
+//	}))
+//
+// Strict mode does not apply, since there is no caller left to receive the exception.
+func Go(fn func(), handlers ...ExceptionHandler) {
+	go func() {
+		Try(fn).Handle(handlers...).complete()
+	}()
+}
@@ -0,0 +1,87 @@
+package goexceptions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ============================================================================
+// EXCEPTION GROUPS: errgroup-style coordination of goroutines
+// ============================================================================
+
+// ExceptionGroup runs goroutines and collects the first exception they throw, like
+// errgroup.Group does for errors. The zero value is usable, without cancellation or
+// limit:
+//
+//	group, ctx := NewExceptionGroup(ctx)
+//	group.SetLimit(8)
+//	for _, id := range ids {
+//	    group.Go(func() { fetch(ctx, id) })
+//	}
+//	if ex := group.Wait(); ex != nil { ... }
+type ExceptionGroup struct {
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	limit  chan struct{}
+	once   sync.Once
+	first  *Exception
+}
+
+// NewExceptionGroup returns a group and a context derived from ctx, canceled when a
+// goroutine of the group first throws or when Wait returns. The cancellation cause
+// is the exception, as an ExceptionError.
+func NewExceptionGroup(ctx context.Context) (*ExceptionGroup, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &ExceptionGroup{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of goroutines running at once to n; Go blocks until one
+// finishes. A negative n removes the limit. It must not be called while goroutines
+// of the group are running.
+func (g *ExceptionGroup) SetLimit(n int) {
+	if n < 0 {
+		g.limit = nil
+		return
+	}
+	if len(g.limit) != 0 {
+		panic(fmt.Errorf("goexceptions: SetLimit called while %d goroutines are running", len(g.limit)))
+	}
+	g.limit = make(chan struct{}, n)
+}
+
+// Go runs fn in a new goroutine of the group, with its own exception capture
+func (g *ExceptionGroup) Go(fn func()) {
+	if g.limit != nil {
+		g.limit <- struct{}{}
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.done()
+		if ex := Try(fn).settle(); ex != nil {
+			g.once.Do(func() {
+				g.first = ex
+				if g.cancel != nil {
+					g.cancel(ex.ToError())
+				}
+			})
+		}
+	}()
+}
+
+func (g *ExceptionGroup) done() {
+	if g.limit != nil {
+		<-g.limit
+	}
+	g.wg.Done()
+}
+
+// Wait waits for every goroutine of the group and returns the first exception
+// thrown, or nil
+func (g *ExceptionGroup) Wait() *Exception {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(nil)
+	}
+	return g.first
+}
@@ -0,0 +1,111 @@
+package goexceptions
+
+import (
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// HANDLER ISOLATION: A panicking handler never crashes the caller
+// ============================================================================
+
+// HandlerFailureException reports a handler that panicked while handling an exception,
+// as opposed to throwing a new exception on purpose. The exception being handled is
+// its inner exception.
+type HandlerFailureException struct {
+	Handler string
+	Failure *Exception // the handler's own panic, converted
+}
+
+func (e HandlerFailureException) Error() string {
+	return fmt.Sprintf("HandlerFailureException: handler '%s' panicked: %s", e.Handler, e.Failure.Error())
+}
+
+func (e HandlerFailureException) TypeName() string {
+	return "HandlerFailureException"
+}
+
+// runHandler invokes a handler that is known to match, isolating its panics
+func (tr *TryResult) runHandler(by handlerRef, call func()) {
+	start := time.Now()
+	failure := tr.callHandler(by, call)
+	tr.recordHandlerStats(by, time.Since(start), failure != nil)
+	tr.markHandled(by)
+	if failure != nil {
+		tr.recordHandlerFailure(by, failure)
+	}
+}
+
+// tryHandler offers the exception to an ExceptionHandler, isolating its panics.
+// A handler that panics is considered to have claimed the exception.
+func (tr *TryResult) tryHandler(by handlerRef, handler ExceptionHandler) bool {
+	if _, timed := handler.(*timedHandler); timed {
+		return tr.tryHandlerUntil(time.Time{}, 0, by, handler)
+	}
+
+	var matched bool
+	start := time.Now()
+	failure := tr.callHandler(by, func() {
+		matched = handler.Handle(*tr.exception)
+	})
+	if failure == nil && !matched {
+		return false
+	}
+
+	tr.recordHandlerStats(by, time.Since(start), failure != nil)
+	tr.markHandled(by)
+	if failure != nil {
+		tr.recordHandlerFailure(by, failure)
+	}
+	return true
+}
+
+// callHandler runs a handler and converts its raw panics (runtime errors, panicked
+// strings or errors) into a failure. Exceptions thrown on purpose from a handler,
+// to translate or rethrow, keep propagating.
+func (tr *TryResult) callHandler(by handlerRef, call func()) (failure *Exception) {
+	defer tr.watchBlocking(by)()
+	defer func() {
+		if r := recover(); r != nil {
+			switch r.(type) {
+			case Exception, ExceptionType:
+				panic(r)
+			}
+			failure = exceptionFromPanic(r)
+		}
+	}()
+	tr.runHandlerLabeled(call)
+	return nil
+}
+
+func (tr *TryResult) recordHandlerFailure(by handlerRef, failure *Exception) {
+	tr.handlerFailure = tr.handlerFailureOf(by, failure)
+	tr.emit(EventHandlerFailed, tr.handlerFailure, "")
+}
+
+// handlerFailureOf wraps the panic of a handler in a HandlerFailureException
+func (tr *TryResult) handlerFailureOf(by handlerRef, failure *Exception) *Exception {
+	if failure.Origin == "" && len(failure.StackTrace) > 0 {
+		failure.Origin = failure.StackTrace[0]
+	}
+	return &Exception{
+		Type:       HandlerFailureException{Handler: by.String(), Failure: failure},
+		StackTrace: failure.StackTrace,
+		Origin:     failure.Origin,
+		Data:       make(map[string]interface{}),
+		Inner:      tr.exception,
+	}
+}
+
+// HandlerFailed reports whether the handler that consumed the exception panicked
+func (tr *TryResult) HandlerFailed() bool {
+	return tr != nil && tr.handlerFailure != nil
+}
+
+// HandlerFailure returns the HandlerFailureException raised while handling, or nil
+func (tr *TryResult) HandlerFailure() *Exception {
+	if tr == nil {
+		return nil
+	}
+	return tr.handlerFailure
+}
@@ -0,0 +1,139 @@
+package goexceptions
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// DECLARATIVE HANDLERS: Discover handler methods on controller-style structs
+// ============================================================================
+
+// HandlerSet is an ordered list of handlers, passed to Handle with HandlerSet...
+type HandlerSet []ExceptionHandler
+
+// HandlersFrom builds a HandlerSet from the methods of obj whose name starts with
+// "Handle" and whose signature is a handler's:
+//
+//	func (c *UserController) HandleNotFound(ex NotFoundException, full Exception)
+//	func (c *UserController) HandleAny(full Exception)
+//
+// A typed method matches like Handler: its exception type, or the types implementing
+// it when it is an interface such as ArgumentException. Methods on concrete types
+// are tried first, then those on interfaces, and a method taking only the Exception
+// catches everything and is tried last. Other methods, including
+// Handle methods with unrelated signatures such as HTTP handlers, are ignored.
+//
+//	Try(func() { c.load(id) }).Handle(HandlersFrom(c)...)
+func HandlersFrom(obj any) HandlerSet {
+	receiver := reflect.ValueOf(obj)
+	methods := handlerMethodsOf(receiver.Type())
+
+	set := make(HandlerSet, 0, len(methods))
+	for _, method := range methods {
+		set = append(set, &methodHandler{
+			name:          method.name,
+			exceptionType: method.exceptionType,
+			fn:            receiver.Method(method.index),
+		})
+	}
+	return set
+}
+
+type handlerMethod struct {
+	index         int
+	name          string
+	exceptionType reflect.Type // nil for a catch-all method
+}
+
+var handlerMethodCache sync.Map // reflect.Type -> []handlerMethod
+
+var exceptionStructType = reflect.TypeOf(Exception{})
+var exceptionTypeInterface = reflect.TypeOf((*ExceptionType)(nil)).Elem()
+
+// handlerMethodsOf finds the handler methods of a type once, most specific first
+func handlerMethodsOf(t reflect.Type) []handlerMethod {
+	if cached, ok := handlerMethodCache.Load(t); ok {
+		return cached.([]handlerMethod)
+	}
+
+	typeName := t.Name()
+	if t.Kind() == reflect.Pointer {
+		typeName = t.Elem().Name()
+	}
+
+	var typed, byInterface, catchAll []handlerMethod
+	for i := 0; i < t.NumMethod(); i++ {
+		method := t.Method(i)
+		signature := method.Type // includes the receiver
+		if !strings.HasPrefix(method.Name, "Handle") || signature.NumOut() != 0 {
+			continue
+		}
+		found := handlerMethod{index: i, name: typeName + "." + method.Name}
+
+		switch signature.NumIn() {
+		case 2:
+			if signature.In(1) == exceptionStructType {
+				catchAll = append(catchAll, found)
+			}
+		case 3:
+			param := signature.In(1)
+			if !param.Implements(exceptionTypeInterface) || signature.In(2) != exceptionStructType {
+				continue
+			}
+			found.exceptionType = param
+			if param.Kind() == reflect.Interface {
+				byInterface = append(byInterface, found)
+			} else {
+				typed = append(typed, found)
+			}
+		}
+	}
+
+	methods := append(append(typed, byInterface...), catchAll...)
+	handlerMethodCache.Store(t, methods)
+	return methods
+}
+
+// methodHandler adapts a discovered method to ExceptionHandler
+type methodHandler struct {
+	name          string
+	exceptionType reflect.Type
+	fn            reflect.Value
+}
+
+func (mh *methodHandler) Handle(ex Exception) bool {
+	if mh.exceptionType == nil {
+		mh.fn.Call([]reflect.Value{reflect.ValueOf(ex)})
+		return true
+	}
+	if !mh.Matches(ex) {
+		return false
+	}
+	exceptionValue := reflect.New(mh.exceptionType).Elem()
+	if path := embedPath(reflect.TypeOf(ex.Type), mh.exceptionType); path != nil {
+		exceptionValue.Set(reflect.ValueOf(ex.Type).FieldByIndex(path))
+	} else {
+		exceptionValue.Set(reflect.ValueOf(ex.Type))
+	}
+	mh.fn.Call([]reflect.Value{exceptionValue, reflect.ValueOf(ex)})
+	return true
+}
+
+// Matches reports whether the method catches ex, without running it
+func (mh *methodHandler) Matches(ex Exception) bool {
+	if mh.exceptionType == nil {
+		return true
+	}
+	if ex.Type == nil {
+		return false
+	}
+	actual := reflect.TypeOf(ex.Type)
+	return actual.AssignableTo(mh.exceptionType) || embedPath(actual, mh.exceptionType) != nil
+}
+
+// HandlerName describes the handler in reports
+func (mh *methodHandler) HandlerName() string {
+	return mh.name
+}
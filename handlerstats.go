@@ -0,0 +1,161 @@
+package goexceptions
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ============================================================================
+// HANDLER STATS: Invocation counts and latencies of handlers
+// ============================================================================
+
+// handlerBuckets is the number of latency buckets: bucket i counts handlers that ran
+// for at most 1µs<<i, and the last one every slower handler
+const handlerBuckets = 32
+
+// HandlerStats counts the invocations of one handler, identified by its name as it
+// appears in reports
+type HandlerStats struct {
+	Handler  string
+	Count    int64
+	Failures int64 // invocations that panicked or timed out
+	Total    time.Duration
+	Max      time.Duration
+	Buckets  [handlerBuckets]int64 // latency histogram, see HandlerBucketBound
+}
+
+// HandlerBucketBound returns the upper latency bound of a HandlerStats bucket
+func HandlerBucketBound(bucket int) time.Duration {
+	if bucket >= handlerBuckets-1 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Microsecond << bucket
+}
+
+// Mean returns the average latency of the handler
+func (s HandlerStats) Mean() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+// Quantile returns an upper bound of the q quantile of the handler's latency, such
+// as 0.99 for its p99, at the resolution of the histogram buckets
+func (s HandlerStats) Quantile(q float64) time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	target := int64(math.Ceil(q * float64(s.Count)))
+	var seen int64
+	for bucket, count := range s.Buckets {
+		seen += count
+		if seen >= target {
+			return min(HandlerBucketBound(bucket), s.Max)
+		}
+	}
+	return s.Max
+}
+
+// SlowHandlerException is reported to observers, with EventDiagnostic, the first time
+// a handler's p99 latency exceeds the threshold set with SetSlowHandlerThreshold. The
+// exception being handled at that moment is its inner exception.
+type SlowHandlerException struct {
+	Handler   string
+	P99       time.Duration
+	Threshold time.Duration
+	Count     int64
+}
+
+func (e SlowHandlerException) Error() string {
+	return fmt.Sprintf("SlowHandlerException: handler '%s' p99 %v exceeds %v over %d invocations", e.Handler, e.P99, e.Threshold, e.Count)
+}
+
+func (e SlowHandlerException) TypeName() string {
+	return "SlowHandlerException"
+}
+
+// slowHandlerMinCount is how many invocations a handler needs before its p99 means
+// anything
+const slowHandlerMinCount = 50
+
+var slowHandlerThreshold atomic.Int64
+
+// SetSlowHandlerThreshold reports handlers whose p99 latency exceeds d, once each
+// until ResetHandlerStats. Zero, the default, disables the check.
+func SetSlowHandlerThreshold(d time.Duration) {
+	slowHandlerThreshold.Store(int64(max(d, 0)))
+}
+
+type handlerStatsEntry struct {
+	stats   HandlerStats
+	flagged bool
+}
+
+var handlerStatsMutex sync.Mutex
+var handlerStatsByName = make(map[string]*handlerStatsEntry)
+
+// StatsByHandler returns a copy of the counters of every handler invoked so far,
+// sorted by name
+func StatsByHandler() []HandlerStats {
+	handlerStatsMutex.Lock()
+	result := make([]HandlerStats, 0, len(handlerStatsByName))
+	for _, entry := range handlerStatsByName {
+		result = append(result, entry.stats)
+	}
+	handlerStatsMutex.Unlock()
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Handler < result[j].Handler })
+	return result
+}
+
+// ResetHandlerStats forgets all handler counters and slow handler reports
+func ResetHandlerStats() {
+	handlerStatsMutex.Lock()
+	defer handlerStatsMutex.Unlock()
+	handlerStatsByName = make(map[string]*handlerStatsEntry)
+}
+
+// recordHandlerStats counts an invocation of a handler that matched the exception
+func (tr *TryResult) recordHandlerStats(by handlerRef, elapsed time.Duration, failed bool) {
+	name := by.String()
+	bucket := min(bits.Len64(uint64(max(elapsed-1, 0)/time.Microsecond)), handlerBuckets-1)
+
+	handlerStatsMutex.Lock()
+	entry, exists := handlerStatsByName[name]
+	if !exists {
+		entry = &handlerStatsEntry{stats: HandlerStats{Handler: name}}
+		handlerStatsByName[name] = entry
+	}
+	stats := &entry.stats
+	stats.Count++
+	if failed {
+		stats.Failures++
+	}
+	stats.Total += elapsed
+	stats.Max = max(stats.Max, elapsed)
+	stats.Buckets[bucket]++
+
+	var slow *SlowHandlerException
+	threshold := time.Duration(slowHandlerThreshold.Load())
+	if threshold > 0 && !entry.flagged && stats.Count >= slowHandlerMinCount {
+		if p99 := stats.Quantile(0.99); p99 > threshold {
+			entry.flagged = true
+			slow = &SlowHandlerException{Handler: name, P99: p99, Threshold: threshold, Count: stats.Count}
+		}
+	}
+	handlerStatsMutex.Unlock()
+
+	if slow != nil {
+		tr.emit(EventDiagnostic, &Exception{
+			Type:  *slow,
+			Data:  make(map[string]interface{}),
+			Inner: tr.exception,
+		}, "")
+	}
+}
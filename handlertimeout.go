@@ -0,0 +1,164 @@
+package goexceptions
+
+import (
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// HANDLER TIMEOUTS: Slow recovery logic cannot hold the caller
+// ============================================================================
+
+// HandlerTimeoutException is recorded as the handler failure when a handler exceeds
+// its deadline. The exception being handled is its inner exception.
+type HandlerTimeoutException struct {
+	Handler string
+	Timeout time.Duration
+	Message string
+}
+
+func (e HandlerTimeoutException) Error() string {
+	return fmt.Sprintf("HandlerTimeoutException: handler '%s' exceeded %v. %s", e.Handler, e.Timeout, e.Message)
+}
+
+func (e HandlerTimeoutException) TypeName() string {
+	return "HandlerTimeoutException"
+}
+
+// timedHandler bounds the run time of a handler used with Handle
+type timedHandler struct {
+	handler ExceptionHandler
+	timeout time.Duration
+}
+
+// Handle runs the handler without a deadline; the deadline applies within Handle
+func (th *timedHandler) Handle(ex Exception) bool {
+	return th.handler.Handle(ex)
+}
+
+// HandlerName describes the handler in reports
+func (th *timedHandler) HandlerName() string {
+	return handlerRef{handler: th.handler}.String()
+}
+
+// HandlerWithin bounds the run time of handler when used with Handle or HandleWithin.
+// See HandleWithin for what happens when the deadline passes.
+func HandlerWithin(d time.Duration, handler ExceptionHandler) ExceptionHandler {
+	return &timedHandler{handler: handler, timeout: d}
+}
+
+// HandleWithin is Handle with a deadline of d shared by all handlers. A handler still
+// running at the deadline is abandoned on its goroutine and considered to have
+// claimed the exception: a HandlerTimeoutException is recorded as the handler
+// failure (see HandlerFailure) and reported to observers, and the chain proceeds to
+// Finally. A later panic of the abandoned handler is reported to observers as well.
+func (tr *TryResult) HandleWithin(d time.Duration, handlers ...ExceptionHandler) *TryResult {
+	tr.passThrough()
+	if tr == nil || tr.exception == nil || tr.handled {
+		return tr
+	}
+
+	tr.runEnrichers(handlers)
+	deadline := time.Now().Add(d)
+	for _, handler := range handlers {
+		if isEnricher(handler) {
+			continue
+		}
+		if tr.tryHandlerUntil(deadline, d, handlerRef{handler: handler}, handler) {
+			break
+		}
+	}
+	return tr
+}
+
+// handlerOutcome is what a handler running on its own goroutine sends back
+type handlerOutcome struct {
+	failure *Exception
+	thrown  any // exception thrown on purpose, to propagate in the caller
+	elapsed time.Duration
+}
+
+// tryHandlerUntil is tryHandler with a deadline. A zero deadline only applies the
+// handler's own timeout, if it was wrapped by HandlerWithin.
+func (tr *TryResult) tryHandlerUntil(deadline time.Time, timeout time.Duration, by handlerRef, handler ExceptionHandler) bool {
+	if timed, ok := handler.(*timedHandler); ok {
+		if own := time.Now().Add(timed.timeout); deadline.IsZero() || own.Before(deadline) {
+			deadline, timeout = own, timed.timeout
+		}
+		handler = timed.handler
+	}
+
+	// The handler runs against a snapshot, with its own Data and owner, so an
+	// abandoned handler never races with the chain completing. A handler returning
+	// in time has its Data and degradation carried over.
+	snapshot := newTryResult(nil)
+	ex := *tr.exception
+	ex.Data, ex.owner = cloneData(ex.Data), snapshot
+	snapshot.exception, snapshot.config = &ex, tr.config
+	var matched bool
+	done := make(chan handlerOutcome, 1)
+	go func() {
+		var outcome handlerOutcome
+		defer func() {
+			if r := recover(); r != nil {
+				outcome.thrown = r
+			}
+			done <- outcome
+		}()
+		start := time.Now()
+		outcome.failure = snapshot.callHandler(by, func() {
+			matched = handler.Handle(ex)
+		})
+		outcome.elapsed = time.Since(start)
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case outcome := <-done:
+		if outcome.thrown != nil {
+			panic(outcome.thrown)
+		}
+		tr.exception.Data = ex.Data
+		if snapshot.degradedReason != "" {
+			tr.degradedReason = snapshot.degradedReason
+		}
+		if outcome.failure == nil && !matched {
+			return false
+		}
+		tr.recordHandlerStats(by, outcome.elapsed, outcome.failure != nil)
+		tr.markHandled(by)
+		if outcome.failure != nil {
+			tr.recordHandlerFailure(by, outcome.failure)
+		}
+	case <-timer.C:
+		tr.recordHandlerStats(by, timeout, true)
+		tr.markHandled(by)
+		tr.handlerFailure = &Exception{
+			Type: HandlerTimeoutException{
+				Handler: by.String(),
+				Timeout: timeout,
+				Message: "handler abandoned, still running in background",
+			},
+			Origin: throwOrigin(),
+			Data:   make(map[string]interface{}),
+			Inner:  tr.exception,
+		}
+		tr.emit(EventHandlerFailed, tr.handlerFailure, "")
+		abandoned.add()
+		go snapshot.watchAbandonedHandler(done)
+	}
+	return true
+}
+
+func (tr *TryResult) watchAbandonedHandler(done <-chan handlerOutcome) {
+	defer abandoned.done()
+	outcome := <-done
+	switch {
+	case outcome.thrown != nil:
+		tr.emit(EventUnhandled, exceptionFromPanic(outcome.thrown), "")
+	case outcome.failure != nil:
+		tr.emit(EventUnhandled, outcome.failure, "")
+	}
+}
@@ -0,0 +1,175 @@
+package goexceptions
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// EXCEPTION HIERARCHIES: Parent types declared by embedding
+// ============================================================================
+
+// BaseException is the root of exception hierarchies. A type declares its parent by
+// embedding it, and BaseException at the root provides Message, Data and the Error
+// and TypeName methods:
+//
+//	type PaymentException struct {
+//	    BaseException
+//	}
+//
+//	type CardDeclinedException struct {
+//	    PaymentException
+//	    Card string
+//	}
+//
+//	Throw(CardDeclinedException{PaymentException: PaymentException{BaseException{Message: "declined"}}})
+//
+// Handler[PaymentException] then catches CardDeclinedException too, receiving its
+// embedded PaymentException. Throw binds the name of the thrown type, so TypeName
+// reports "CardDeclinedException" even through the parent, and copies Data to the
+// Data of the exception.
+type BaseException struct {
+	Message string
+	Data    map[string]interface{}
+
+	typeName string
+}
+
+func (e BaseException) Error() string {
+	if e.Message == "" {
+		return e.TypeName()
+	}
+	return e.TypeName() + ": " + e.Message
+}
+
+func (e BaseException) TypeName() string {
+	if e.typeName != "" {
+		return e.typeName
+	}
+	return "BaseException"
+}
+
+var baseExceptionType = reflect.TypeOf(BaseException{})
+
+// bindBaseException returns a copy of ex with its embedded BaseException bound to
+// the outer type, or ex itself for any other type
+func bindBaseException(ex ExceptionType) ExceptionType {
+	t := reflect.TypeOf(ex)
+	path := embedPath(t, baseExceptionType)
+	if path == nil {
+		return ex
+	}
+
+	value := reflect.New(t).Elem()
+	value.Set(reflect.ValueOf(ex))
+	value.FieldByIndex(path).Addr().Interface().(*BaseException).typeName = typeNameOf(t)
+	return value.Interface().(ExceptionType)
+}
+
+// inheritData copies the Data declared on an embedded BaseException to the exception
+func inheritData(ex *Exception) {
+	base, ok := exceptionAs[BaseException](ex.Type)
+	if !ok || len(base.Data) == 0 {
+		return
+	}
+	if ex.Data == nil {
+		ex.Data = make(map[string]interface{}, len(base.Data))
+	}
+	for key, value := range base.Data {
+		if _, exists := ex.Data[key]; !exists {
+			ex.Data[key] = value
+		}
+	}
+}
+
+// maxEmbedDepth bounds the search for parent types
+const maxEmbedDepth = 8
+
+var embedPaths sync.Map // typePair -> []int, nil when parent is not embedded
+
+// embedPath returns the field index path of parent embedded in t, through exported
+// anonymous struct fields implementing ExceptionType, or nil
+func embedPath(t, parent reflect.Type) []int {
+	if t == nil || t.Kind() != reflect.Struct || parent.Kind() != reflect.Struct || t == parent {
+		return nil
+	}
+	key := typePair{expected: parent, actual: t}
+	if cached, ok := embedPaths.Load(key); ok {
+		return cached.([]int)
+	}
+
+	path := findEmbedded(t, parent, 0)
+	embedPaths.Store(key, path)
+	return path
+}
+
+func findEmbedded(t, parent reflect.Type, depth int) []int {
+	if depth >= maxEmbedDepth {
+		return nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.Anonymous || !field.IsExported() || field.Type.Kind() != reflect.Struct ||
+			!field.Type.Implements(exceptionTypeInterface) {
+			continue
+		}
+		if field.Type == parent {
+			return []int{i}
+		}
+		if rest := findEmbedded(field.Type, parent, depth+1); rest != nil {
+			return append([]int{i}, rest...)
+		}
+	}
+	return nil
+}
+
+// exceptionAs returns ex as a T: ex itself when it is a T, or its embedded parent
+// of type T
+func exceptionAs[T any](ex ExceptionType) (T, bool) {
+	if typed, ok := ex.(T); ok {
+		return typed, true
+	}
+	var zero T
+	path := embedPath(reflect.TypeOf(ex), getTypeOf[T]())
+	if path == nil {
+		return zero, false
+	}
+	return reflect.ValueOf(ex).FieldByIndex(path).Interface().(T), true
+}
+
+// TypeHierarchy returns the names of the exception type and of the parent types it
+// embeds, from the type itself to the root, such as
+// [CardDeclinedException PaymentException BaseException]
+func TypeHierarchy(ex ExceptionType) []string {
+	if ex == nil {
+		return nil
+	}
+	names := []string{ex.TypeName()}
+	for _, parent := range parentTypes(reflect.TypeOf(ex)) {
+		names = append(names, typeNameOf(parent))
+	}
+	return names
+}
+
+// parentTypes returns the chain of exception types embedded by t, nearest first,
+// following the first embedded exception type at each level
+func parentTypes(t reflect.Type) []reflect.Type {
+	var parents []reflect.Type
+	for depth := 0; t.Kind() == reflect.Struct && depth < maxEmbedDepth; depth++ {
+		var parent reflect.Type
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous && field.IsExported() && field.Type.Kind() == reflect.Struct &&
+				field.Type.Implements(exceptionTypeInterface) {
+				parent = field.Type
+				break
+			}
+		}
+		if parent == nil {
+			break
+		}
+		parents = append(parents, parent)
+		t = parent
+	}
+	return parents
+}
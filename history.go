@@ -0,0 +1,147 @@
+package goexceptions
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// HISTORY: Bounded record of the most recently caught exceptions
+// ============================================================================
+
+// HistoryEntry records one exception caught by Try
+type HistoryEntry struct {
+	Time      time.Time
+	Name      string // operation name given with WithName
+	Tenant    string
+	Exception *Exception
+}
+
+// History is kept in fixed-size chunks that are only ever appended to. A snapshot is
+// the list of chunks plus the fill level of the last one, so iterating it needs no
+// copy of the entries and never observes a slot being overwritten.
+const historyChunkSize = 64
+
+type historyChunk struct {
+	entries [historyChunkSize]HistoryEntry
+}
+
+type historySnapshot struct {
+	chunks  []*historyChunk
+	tailLen int // filled entries in the last chunk
+	skip    int // oldest entries beyond the limit
+}
+
+const defaultHistoryLimit = 256
+
+var historyMutex sync.Mutex
+var historyLimit = defaultHistoryLimit
+var historyChunks []*historyChunk
+var historyTailLen int
+
+// SetHistoryLimit sets how many of the most recent exceptions are kept (256 by
+// default). Zero disables the history. The current history is cleared.
+func SetHistoryLimit(n int) {
+	if n < 0 {
+		n = 0
+	}
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+	historyLimit = n
+	historyChunks, historyTailLen = nil, 0
+}
+
+// ClearHistory forgets all recorded exceptions
+func ClearHistory() {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+	historyChunks, historyTailLen = nil, 0
+}
+
+// RangeHistory calls fn for every recorded exception, oldest first, until fn returns
+// false. It iterates a snapshot taken at the call: exceptions caught meanwhile, even
+// by fn itself, are not visited.
+func RangeHistory(fn func(entry HistoryEntry) bool) {
+	snapshot := takeHistorySnapshot()
+	skip := snapshot.skip
+	for i, chunk := range snapshot.chunks {
+		n := historyChunkSize
+		if i == len(snapshot.chunks)-1 {
+			n = snapshot.tailLen
+		}
+		for j := 0; j < n; j++ {
+			if skip > 0 {
+				skip--
+				continue
+			}
+			if !fn(chunk.entries[j]) {
+				return
+			}
+		}
+	}
+}
+
+// RangeStats calls fn with the counters of every fingerprint, in order of first
+// occurrence, until fn returns false. Like RangeHistory it works on a snapshot.
+func RangeStats(fn func(stats ExceptionStats) bool) {
+	snapshot := Stats()
+	sort.Slice(snapshot, func(i, j int) bool {
+		if snapshot[i].First.Equal(snapshot[j].First) {
+			return snapshot[i].Fingerprint < snapshot[j].Fingerprint
+		}
+		return snapshot[i].First.Before(snapshot[j].First)
+	})
+	for _, stats := range snapshot {
+		if !fn(stats) {
+			return
+		}
+	}
+}
+
+func takeHistorySnapshot() historySnapshot {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+	if len(historyChunks) == 0 {
+		return historySnapshot{}
+	}
+
+	total := (len(historyChunks)-1)*historyChunkSize + historyTailLen
+	snapshot := historySnapshot{
+		chunks:  make([]*historyChunk, len(historyChunks)),
+		tailLen: historyTailLen,
+	}
+	copy(snapshot.chunks, historyChunks)
+	if total > historyLimit {
+		snapshot.skip = total - historyLimit
+	}
+	return snapshot
+}
+
+// recordHistory appends a copy of an exception caught by Try, as it was when caught:
+// handlers and enrichers changing the exception later leave the entry alone
+func recordHistory(tr *TryResult, ex *Exception, now time.Time) {
+	recorded := *ex
+	recorded.Data = cloneData(ex.Data)
+	entry := HistoryEntry{Time: now, Name: tr.config.name, Tenant: tr.Tenant(), Exception: &recorded}
+
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+	if historyLimit == 0 {
+		return
+	}
+
+	if len(historyChunks) == 0 || historyTailLen == historyChunkSize {
+		// keep just enough whole chunks to hold the limit; older ones are dropped
+		// from the list, while snapshots still referencing them stay valid
+		maxChunks := (historyLimit+historyChunkSize-1)/historyChunkSize + 1
+		if len(historyChunks) >= maxChunks {
+			historyChunks = append(historyChunks[:0:0], historyChunks[len(historyChunks)-maxChunks+1:]...)
+		}
+		historyChunks = append(historyChunks, new(historyChunk))
+		historyTailLen = 0
+	}
+
+	historyChunks[len(historyChunks)-1].entries[historyTailLen] = entry
+	historyTailLen++
+}
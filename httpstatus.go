@@ -0,0 +1,59 @@
+package goexceptions
+
+import (
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// HTTP STATUS MAPPING: Status codes for exceptions reaching an HTTP boundary
+// ============================================================================
+
+var httpStatusMutex sync.RWMutex
+var httpStatuses = make(map[reflect.Type]int)
+
+// defaultHTTPStatuses cover the built-in types that describe a client problem
+var defaultHTTPStatuses = map[reflect.Type]int{
+	reflect.TypeOf(ArgumentNullException{}):       http.StatusBadRequest,
+	reflect.TypeOf(ArgumentOutOfRangeException{}): http.StatusBadRequest,
+	reflect.TypeOf(AuthenticationException{}):     http.StatusUnauthorized,
+	reflect.TypeOf(AuthorizationException{}):      http.StatusForbidden,
+	reflect.TypeOf(ConcurrencyException{}):        http.StatusConflict,
+	reflect.TypeOf(TimeoutException{}):            http.StatusGatewayTimeout,
+	reflect.TypeOf(CircuitOpenException{}):        http.StatusServiceUnavailable,
+}
+
+// RegisterHTTPStatus sets the HTTP status code for exceptions of type T
+func RegisterHTTPStatus[T ExceptionType](status int) {
+	checkTypeNameOf[T]()
+	httpStatusMutex.Lock()
+	defer httpStatusMutex.Unlock()
+	httpStatuses[getTypeOf[T]()] = status
+}
+
+// UnregisterHTTPStatus removes the status code for exceptions of type T, restoring its default
+func UnregisterHTTPStatus[T ExceptionType]() {
+	httpStatusMutex.Lock()
+	defer httpStatusMutex.Unlock()
+	delete(httpStatuses, getTypeOf[T]())
+}
+
+// HTTPStatusFor returns the HTTP status code to answer with when ex reaches an HTTP
+// handler: the registered code for its type, its built-in default, or 500
+func HTTPStatusFor(ex *Exception) int {
+	if ex == nil || ex.Type == nil {
+		return http.StatusInternalServerError
+	}
+	exceptionType := reflect.TypeOf(ex.Type)
+
+	httpStatusMutex.RLock()
+	defer httpStatusMutex.RUnlock()
+	if status, exists := httpStatuses[exceptionType]; exists {
+		return status
+	}
+	if status, exists := defaultHTTPStatuses[exceptionType]; exists {
+		return status
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,85 @@
+package goexceptions
+
+import (
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// IDEMPOTENCY: Suppress duplicate reports for retried operations
+// ============================================================================
+
+// WithIdempotencyKey tags the Try with the key of the logical operation it performs.
+// Retries of the same operation reuse the key, so duplicate reports can be suppressed
+// (see SetDuplicateWindow).
+func WithIdempotencyKey(key string) TryOption {
+	return func(c *tryConfig) {
+		c.idempotencyKey = key
+	}
+}
+
+// IdempotencyKey returns the key given with WithIdempotencyKey
+func (tr *TryResult) IdempotencyKey() string {
+	if tr == nil {
+		return ""
+	}
+	return tr.config.idempotencyKey
+}
+
+type duplicateEntry struct {
+	windowStart time.Time
+	suppressed  int
+}
+
+var duplicateMutex sync.Mutex
+var duplicateWindow time.Duration
+var duplicates = make(map[string]*duplicateEntry)
+
+// SetDuplicateWindow enables suppression of repeated events with the same tenant,
+// idempotency key, event kind and fingerprint within d. Suppressed events are
+// counted, and the next delivered event carries the total in Event.Occurrences.
+// Zero disables it.
+func SetDuplicateWindow(d time.Duration) {
+	duplicateMutex.Lock()
+	defer duplicateMutex.Unlock()
+	duplicateWindow = d
+	duplicates = make(map[string]*duplicateEntry)
+}
+
+// admitEvent decides whether an event is delivered and how many occurrences it stands for
+func admitEvent(kind EventKind, tenant, key string, ex *Exception, now time.Time) (deliver bool, occurrences int) {
+	if key == "" || ex == nil {
+		return true, 1
+	}
+
+	duplicateMutex.Lock()
+	defer duplicateMutex.Unlock()
+	if duplicateWindow <= 0 {
+		return true, 1
+	}
+
+	dedupKey := tenant + "|" + key + "|" + kind.String() + "|" + ex.Fingerprint()
+	entry, exists := duplicates[dedupKey]
+	if exists && now.Sub(entry.windowStart) < duplicateWindow {
+		entry.suppressed++
+		return false, 0
+	}
+
+	occurrences = 1
+	if exists {
+		occurrences += entry.suppressed
+	}
+	if len(duplicates) >= 4096 {
+		pruneDuplicates(now)
+	}
+	duplicates[dedupKey] = &duplicateEntry{windowStart: now}
+	return true, occurrences
+}
+
+func pruneDuplicates(now time.Time) {
+	for key, entry := range duplicates {
+		if now.Sub(entry.windowStart) >= duplicateWindow {
+			delete(duplicates, key)
+		}
+	}
+}
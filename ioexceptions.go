@@ -0,0 +1,73 @@
+package goexceptions
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ============================================================================
+// I/O: Readers and writers that throw instead of returning errors
+// ============================================================================
+
+// IOException reports a failed read or write
+type IOException struct {
+	Operation string // "read" or "write"
+	Message   string
+	Cause     error
+}
+
+func (e IOException) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("IOException: %s (Operation: %s, Cause: %v)", e.Message, e.Operation, e.Cause)
+	}
+	return fmt.Sprintf("IOException: %s (Operation: %s)", e.Message, e.Operation)
+}
+
+func (e IOException) TypeName() string {
+	return "IOException"
+}
+
+func ThrowIOError(operation, message string, cause error) {
+	Throw(IOException{Operation: operation, Message: message, Cause: cause})
+}
+
+type throwingReader struct {
+	r io.Reader
+}
+
+// ThrowingReader wraps r so that read errors are thrown as IOException. io.EOF is
+// not exceptional: it is still returned, so the reader works with io.Copy,
+// io.ReadAll, bufio and friends.
+func ThrowingReader(r io.Reader) io.Reader {
+	return &throwingReader{r: r}
+}
+
+func (tr *throwingReader) Read(p []byte) (int, error) {
+	n, err := tr.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		ThrowIOError("read", "read failed", err)
+	}
+	return n, err
+}
+
+type throwingWriter struct {
+	w io.Writer
+}
+
+// ThrowingWriter wraps w so that write errors, including short writes, are thrown
+// as IOException
+func ThrowingWriter(w io.Writer) io.Writer {
+	return &throwingWriter{w: w}
+}
+
+func (tw *throwingWriter) Write(p []byte) (int, error) {
+	n, err := tw.w.Write(p)
+	if err == nil && n < len(p) {
+		err = io.ErrShortWrite
+	}
+	if err != nil {
+		ThrowIOError("write", fmt.Sprintf("wrote %d of %d bytes", n, len(p)), err)
+	}
+	return n, nil
+}
@@ -0,0 +1,210 @@
+package goexceptions
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// ============================================================================
+// JSON: Exceptions as structured JSON, and back
+// ============================================================================
+
+// RemoteException stands for an exception decoded from JSON whose type is not known
+// to the process. Handler[RemoteException] catches them all.
+type RemoteException struct {
+	Name    string                 // TypeName of the original exception
+	Message string                 // Error of the original exception
+	Code    string                 // Code field of the original exception
+	Fields  map[string]interface{} // exported fields of the original exception
+}
+
+func (e RemoteException) Error() string {
+	return e.Message
+}
+
+var remoteExceptionType = reflect.TypeOf(RemoteException{})
+
+func (e RemoteException) TypeName() string {
+	if e.Name == "" {
+		return "RemoteException"
+	}
+	return e.Name
+}
+
+// exceptionJSON is the JSON schema of an Exception
+type exceptionJSON struct {
+	Type    string                     `json:"type"`
+	Message string                     `json:"message"`
+	Code    string                     `json:"code,omitempty"`
+	Fields  map[string]json.RawMessage `json:"fields,omitempty"`
+	Origin  string                     `json:"origin,omitempty"`
+	Stack   []string                   `json:"stack,omitempty"`
+	Data    map[string]interface{}     `json:"data,omitempty"`
+	Cause   string                     `json:"cause,omitempty"`
+	Inner   *Exception                 `json:"inner,omitempty"`
+}
+
+var errorInterface = reflect.TypeOf((*error)(nil)).Elem()
+var exceptionPointerType = reflect.TypeOf((*Exception)(nil))
+
+// MarshalJSON implements json.Marshaler with a stable schema: type (the TypeName),
+// message, code, fields (the exported fields of the exception type), origin, stack,
+// data (redacted, see RedactedData), cause and inner (the inner exception, in the
+// same schema):
+//
+//	{"type":"NetworkException","message":"NetworkException: ...","fields":{"URL":"https://..."},"inner":{...}}
+//
+// Error values, such as the Cause of built-in types, are written as their message.
+func (e Exception) MarshalJSON() ([]byte, error) {
+	fields, err := exceptionFields(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	wire := exceptionJSON{
+		Type:    e.TypeName(),
+		Message: e.Error(),
+		Code:    codeOf(e.Type),
+		Fields:  fields,
+		Origin:  e.Origin,
+		Stack:   e.StackTrace,
+		Data:    e.RedactedData(),
+		Inner:   e.Inner,
+	}
+	if e.cause != nil {
+		wire.Cause = e.cause.Error()
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The exception type is rebuilt from its
+// name when the process knows it: built-in types, custom types registered with
+// RegisterExceptionType, and those registered with any other Register function or
+// Preload, or already caught. Other types become a RemoteException. Error values
+// arrive as a RemoteError carrying the message.
+func (e *Exception) UnmarshalJSON(data []byte) error {
+	var wire exceptionJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	*e = Exception{
+		Type:       decodeExceptionType(wire),
+		StackTrace: wire.Stack,
+		Origin:     wire.Origin,
+		Data:       wire.Data,
+		Inner:      wire.Inner,
+	}
+	if e.Data == nil {
+		e.Data = make(map[string]interface{})
+	}
+	if wire.Cause != "" {
+		e.cause = RemoteError{Message: wire.Cause}
+	}
+	return nil
+}
+
+// exceptionFields encodes the exported fields of an exception type, those of
+// embedded structs included
+func exceptionFields(exceptionType ExceptionType) (map[string]json.RawMessage, error) {
+	value := reflect.ValueOf(exceptionType)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	fields := make(map[string]json.RawMessage)
+	var err error
+	rangeExportedFields(value, func(field reflect.StructField, fieldValue reflect.Value) {
+		var encoded interface{}
+		switch {
+		case field.Type == errorInterface:
+			if !fieldValue.IsNil() {
+				encoded = fieldValue.Interface().(error).Error()
+			}
+		case field.Type == exceptionPointerType || field.Type.Kind() == reflect.Slice && field.Type.Elem() == exceptionPointerType:
+			encoded = fieldValue.Interface()
+		default:
+			encoded = SafeValue(fieldValue.Interface())
+		}
+		raw, marshalErr := json.Marshal(encoded)
+		if marshalErr != nil {
+			err = fmt.Errorf("field %s: %w", field.Name, marshalErr)
+			return
+		}
+		fields[field.Name] = raw
+	})
+	return fields, err
+}
+
+// decodeExceptionType rebuilds the exception type of wire, leaving out the fields
+// that do not fit
+func decodeExceptionType(wire exceptionJSON) ExceptionType {
+	t, known := exceptionTypeNamed(wire.Type)
+	base := t
+	if known && base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	if !known || base.Kind() != reflect.Struct || base == remoteExceptionType {
+		return remoteException(wire)
+	}
+
+	value := reflect.New(base).Elem()
+	rangeExportedFields(value, func(field reflect.StructField, fieldValue reflect.Value) {
+		raw, present := wire.Fields[field.Name]
+		if !present {
+			return
+		}
+		if field.Type == errorInterface {
+			var message string
+			if json.Unmarshal(raw, &message) == nil && message != "" {
+				fieldValue.Set(reflect.ValueOf(RemoteError{Message: message}))
+			}
+			return
+		}
+		target := reflect.New(field.Type)
+		if json.Unmarshal(raw, target.Interface()) == nil {
+			fieldValue.Set(target.Elem())
+		}
+	})
+
+	if t.Kind() == reflect.Pointer {
+		value = value.Addr()
+	}
+	exceptionType, ok := value.Interface().(ExceptionType)
+	if !ok {
+		return remoteException(wire)
+	}
+	return bindException(exceptionType)
+}
+
+func remoteException(wire exceptionJSON) RemoteException {
+	remote := RemoteException{Name: wire.Type, Message: wire.Message, Code: wire.Code}
+	if len(wire.Fields) > 0 {
+		remote.Fields = make(map[string]interface{}, len(wire.Fields))
+		for name, raw := range wire.Fields {
+			var value interface{}
+			json.Unmarshal(raw, &value)
+			remote.Fields[name] = value
+		}
+	}
+	return remote
+}
+
+// rangeExportedFields calls fn for the exported fields of a struct value, descending
+// into embedded structs
+func rangeExportedFields(value reflect.Value, fn func(field reflect.StructField, fieldValue reflect.Value)) {
+	t := value.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			rangeExportedFields(value.Field(i), fn)
+			continue
+		}
+		if field.IsExported() {
+			fn(field, value.Field(i))
+		}
+	}
+}
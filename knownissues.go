@@ -0,0 +1,92 @@
+package goexceptions
+
+import (
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// KNOWN ISSUES: Acknowledged failures logged quietly until they expire
+// ============================================================================
+
+// KnownIssue acknowledges the exceptions of one fingerprint as a tracked, not yet
+// fixed failure
+type KnownIssue struct {
+	Fingerprint string
+	Ticket      string // reference of the tracking ticket, such as "PAY-1432"
+	Expires     time.Time
+}
+
+// KnownIssueLogLevel is the highest level at which exceptions of a known issue are
+// logged (see LogLevelFor)
+var KnownIssueLogLevel = slog.LevelInfo
+
+var knownIssuesMutex sync.Mutex
+var knownIssues = make(map[string]KnownIssue)
+
+// RegisterKnownIssue acknowledges the exceptions with fingerprint (see
+// Exception.Fingerprint) for ttl. Until then they are logged at KnownIssueLogLevel at
+// most, and logs and reports carry the ticket. Registering a fingerprint again
+// replaces its ticket and expiry.
+func RegisterKnownIssue(fingerprint, ticket string, ttl time.Duration) {
+	now := time.Now()
+
+	knownIssuesMutex.Lock()
+	defer knownIssuesMutex.Unlock()
+	for key, issue := range knownIssues {
+		if !now.Before(issue.Expires) {
+			delete(knownIssues, key)
+		}
+	}
+	knownIssues[fingerprint] = KnownIssue{Fingerprint: fingerprint, Ticket: ticket, Expires: now.Add(ttl)}
+}
+
+// UnregisterKnownIssue removes the known issue of a fingerprint, typically once the
+// fix is deployed
+func UnregisterKnownIssue(fingerprint string) {
+	knownIssuesMutex.Lock()
+	defer knownIssuesMutex.Unlock()
+	delete(knownIssues, fingerprint)
+}
+
+// KnownIssues returns the known issues that have not expired, sorted by fingerprint
+func KnownIssues() []KnownIssue {
+	now := time.Now()
+
+	knownIssuesMutex.Lock()
+	result := make([]KnownIssue, 0, len(knownIssues))
+	for _, issue := range knownIssues {
+		if now.Before(issue.Expires) {
+			result = append(result, issue)
+		}
+	}
+	knownIssuesMutex.Unlock()
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Fingerprint < result[j].Fingerprint })
+	return result
+}
+
+// KnownIssueFor returns the unexpired known issue matching the fingerprint of ex
+func KnownIssueFor(ex *Exception) (KnownIssue, bool) {
+	if ex == nil || ex.Type == nil {
+		return KnownIssue{}, false
+	}
+	knownIssuesMutex.Lock()
+	defer knownIssuesMutex.Unlock()
+	if len(knownIssues) == 0 {
+		return KnownIssue{}, false
+	}
+
+	fingerprint := ex.Fingerprint()
+	issue, exists := knownIssues[fingerprint]
+	if !exists {
+		return KnownIssue{}, false
+	}
+	if !time.Now().Before(issue.Expires) {
+		delete(knownIssues, fingerprint)
+		return KnownIssue{}, false
+	}
+	return issue, true
+}
@@ -0,0 +1,74 @@
+package goexceptions
+
+import "runtime"
+
+// ============================================================================
+// LEAK SENTINEL: Goroutine and allocation deltas of a Try in debug mode
+// ============================================================================
+
+// ResourceDelta is the change in goroutines and heap allocations across a Try block
+type ResourceDelta struct {
+	Goroutines int    // goroutines started by the block and still running
+	Allocs     uint64 // heap objects allocated
+	Bytes      uint64 // heap bytes allocated
+}
+
+// WithLeakCheck records goroutine and allocation counts before and after the block
+// and attaches the deltas to the report (see TryResult.Resources). It only takes
+// effect in debug mode (see SetDebugMode): reading allocation counts stops the world.
+func WithLeakCheck() TryOption {
+	return func(c *tryConfig) {
+		c.leakCheck = true
+	}
+}
+
+type resourceProbe struct {
+	goroutines int
+	allocs     uint64
+	bytes      uint64
+}
+
+func readResources() resourceProbe {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return resourceProbe{
+		goroutines: runtime.NumGoroutine(),
+		allocs:     stats.Mallocs,
+		bytes:      stats.TotalAlloc,
+	}
+}
+
+// startLeakCheck returns the counts before the block, or nil when not checking
+func (tr *TryResult) startLeakCheck() *resourceProbe {
+	if !tr.config.leakCheck || !DebugMode() {
+		return nil
+	}
+	before := readResources()
+	return &before
+}
+
+func (tr *TryResult) finishLeakCheck(before *resourceProbe) {
+	if before == nil {
+		return
+	}
+	after := readResources()
+	tr.resources = &ResourceDelta{
+		Goroutines: after.goroutines - before.goroutines,
+		Allocs:     after.allocs - before.allocs,
+		Bytes:      after.bytes - before.bytes,
+	}
+}
+
+// Resources returns the deltas recorded with WithLeakCheck, or false when none were
+func (tr *TryResult) Resources() (ResourceDelta, bool) {
+	if tr == nil || tr.resources == nil {
+		return ResourceDelta{}, false
+	}
+	return *tr.resources, true
+}
+
+// LeakSuspected reports whether the block threw while leaving goroutines running,
+// as recorded with WithLeakCheck. Failures are where leaks usually hide.
+func (tr *TryResult) LeakSuspected() bool {
+	return tr != nil && tr.exception != nil && tr.resources != nil && tr.resources.Goroutines > 0
+}
@@ -0,0 +1,116 @@
+package goexceptions
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// LIFECYCLE: Startup/shutdown phases with exception reporting
+// ============================================================================
+
+// LifecycleException reports which lifecycle phase failed
+type LifecycleException struct {
+	Stage     string   // "start" or "stop"
+	Phase     string   // name of the failing phase(s)
+	Completed []string // phases that completed successfully before the failure
+	Message   string
+}
+
+func (e LifecycleException) Error() string {
+	return fmt.Sprintf("LifecycleException: %s phase '%s' failed (completed: %s). %s",
+		e.Stage, e.Phase, strings.Join(e.Completed, ", "), e.Message)
+}
+
+func (e LifecycleException) TypeName() string {
+	return "LifecycleException"
+}
+
+type lifecyclePhase struct {
+	name string
+	fn   func()
+}
+
+// Lifecycle runs named startup and shutdown phases. The zero value is ready to use.
+type Lifecycle struct {
+	mu         sync.Mutex
+	startHooks []lifecyclePhase
+	stopHooks  []lifecyclePhase
+}
+
+// NewLifecycle creates an empty lifecycle
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{}
+}
+
+// OnStart registers a phase executed by Start, in registration order
+func (lc *Lifecycle) OnStart(name string, fn func()) *Lifecycle {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.startHooks = append(lc.startHooks, lifecyclePhase{name: name, fn: fn})
+	return lc
+}
+
+// OnStop registers a phase executed by Stop, in reverse registration order
+func (lc *Lifecycle) OnStop(name string, fn func()) *Lifecycle {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.stopHooks = append(lc.stopHooks, lifecyclePhase{name: name, fn: fn})
+	return lc
+}
+
+// Start runs the start phases in order. The first phase that throws aborts startup
+// with a LifecycleException whose inner exception is the phase failure.
+func (lc *Lifecycle) Start() {
+	lc.mu.Lock()
+	phases := append([]lifecyclePhase(nil), lc.startHooks...)
+	lc.mu.Unlock()
+
+	var completed []string
+	for _, phase := range phases {
+		if ex := Try(phase.fn).settle(); ex != nil {
+			ThrowWithInner(LifecycleException{
+				Stage:     "start",
+				Phase:     phase.name,
+				Completed: completed,
+				Message:   "startup aborted",
+			}, ex)
+		}
+		completed = append(completed, phase.name)
+	}
+}
+
+// Stop runs every stop phase in reverse order, even if some of them throw.
+// Failures are collected into an AggregateException attached as the inner exception
+// of the thrown LifecycleException.
+func (lc *Lifecycle) Stop() {
+	lc.mu.Lock()
+	phases := append([]lifecyclePhase(nil), lc.stopHooks...)
+	lc.mu.Unlock()
+
+	var completed, failed []string
+	var failures []*Exception
+	for i := len(phases) - 1; i >= 0; i-- {
+		phase := phases[i]
+		if ex := Try(phase.fn).settle(); ex != nil {
+			failed = append(failed, phase.name)
+			failures = append(failures, ex)
+			continue
+		}
+		completed = append(completed, phase.name)
+	}
+
+	if len(failures) > 0 {
+		ThrowWithInner(LifecycleException{
+			Stage:     "stop",
+			Phase:     strings.Join(failed, ", "),
+			Completed: completed,
+			Message:   "shutdown completed with errors",
+		}, &Exception{
+			Type:       AggregateException{Message: "stop phases failed", Exceptions: failures},
+			StackTrace: getStackTrace(),
+			Data:       make(map[string]interface{}),
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package goexceptions
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ============================================================================
+// MATCHERS: Pluggable strategies for selecting handlers
+// ============================================================================
+
+// Matcher decides whether a handler applies to an exception. The built-in matchers
+// select by exact type, assignability, interface, TypeName or tag; custom matchers
+// can select by anything else, such as a range of error codes:
+//
+//	clientErrors := MatcherFunc(func(ex *Exception) bool {
+//	    status, ok := ex.Type.(HTTPException)
+//	    return ok && status.Code >= 400 && status.Code < 500
+//	})
+type Matcher interface {
+	Match(ex *Exception) bool
+}
+
+// MatcherFunc adapts a function to the Matcher interface
+type MatcherFunc func(ex *Exception) bool
+
+func (f MatcherFunc) Match(ex *Exception) bool {
+	return f(ex)
+}
+
+// Tagged is implemented by exception types that carry tags, for MatchTag
+type Tagged interface {
+	Tags() []string
+}
+
+// exactMatcher matches one exception type, and not the types implementing it
+type exactMatcher[T any] struct{}
+
+func (exactMatcher[T]) Match(ex *Exception) bool {
+	return ex.Type != nil && reflect.TypeOf(ex.Type) == getTypeOf[T]()
+}
+
+func (exactMatcher[T]) String() string {
+	return "Exact[" + getTypeOf[T]().Name() + "]"
+}
+
+// MatchExact matches exceptions of type T exactly. Unlike Handler, an interface T
+// matches nothing, since exception values are never of an interface type.
+func MatchExact[T ExceptionType]() Matcher {
+	return exactMatcher[T]{}
+}
+
+type assignableMatcher struct {
+	target reflect.Type
+}
+
+func (m assignableMatcher) Match(ex *Exception) bool {
+	return ex.Type != nil && reflect.TypeOf(ex.Type).AssignableTo(m.target)
+}
+
+func (m assignableMatcher) String() string {
+	return "Assignable[" + m.target.String() + "]"
+}
+
+// MatchAssignable matches exceptions whose type is assignable to T. For an interface
+// T, these are the types whose value method set implements it.
+func MatchAssignable[T any]() Matcher {
+	return assignableMatcher{target: getTypeOf[T]()}
+}
+
+type interfaceMatcher struct {
+	target reflect.Type
+}
+
+func (m interfaceMatcher) Match(ex *Exception) bool {
+	if ex.Type == nil {
+		return false
+	}
+	actual := reflect.TypeOf(ex.Type)
+	return actual.Implements(m.target) || reflect.PointerTo(actual).Implements(m.target)
+}
+
+func (m interfaceMatcher) String() string {
+	return "Interface[" + m.target.String() + "]"
+}
+
+// MatchInterface matches exceptions whose type implements the interface I, counting
+// methods declared on the pointer receiver. It panics if I is not an interface.
+func MatchInterface[I any]() Matcher {
+	target := getTypeOf[I]()
+	if target.Kind() != reflect.Interface {
+		panic("goexceptions: MatchInterface requires an interface type, got " + target.String())
+	}
+	return interfaceMatcher{target: target}
+}
+
+type nameMatcher []string
+
+func (m nameMatcher) Match(ex *Exception) bool {
+	return ex.Type != nil && slices.Contains(m, ex.TypeName())
+}
+
+func (m nameMatcher) String() string {
+	return "Name[" + strings.Join(m, ",") + "]"
+}
+
+// MatchName matches exceptions by TypeName, which also selects types declared in
+// packages the caller cannot import
+func MatchName(typeNames ...string) Matcher {
+	return nameMatcher(typeNames)
+}
+
+type namedTypeMatcher []string
+
+func (m namedTypeMatcher) Match(ex *Exception) bool {
+	if ex.Type == nil {
+		return false
+	}
+	name := ex.TypeName()
+	actual := reflect.TypeOf(ex.Type)
+	for _, typeName := range m {
+		if typeName == name {
+			return true
+		}
+		if t, known := exceptionTypeNamed(typeName); known && typeMatches(t, actual) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m namedTypeMatcher) String() string {
+	return "NamedType[" + strings.Join(m, ",") + "]"
+}
+
+// MatchNamedType matches exceptions by TypeName like MatchName and, for the names of
+// types the process knows (see RegisterExceptionType), every exception a Handler of
+// that type would catch, such as types embedding it. Names can then come from
+// configuration:
+//
+//	HandlerMatch(MatchNamedType(config.RetryOn...), retryLater)
+func MatchNamedType(typeNames ...string) Matcher {
+	return namedTypeMatcher(typeNames)
+}
+
+type tagMatcher string
+
+func (m tagMatcher) Match(ex *Exception) bool {
+	tagged, ok := ex.Type.(Tagged)
+	return ok && slices.Contains(tagged.Tags(), string(m))
+}
+
+func (m tagMatcher) String() string {
+	return "Tag[" + string(m) + "]"
+}
+
+// MatchTag matches exceptions whose type implements Tagged and carries tag
+func MatchTag(tag string) Matcher {
+	return tagMatcher(tag)
+}
+
+// MatcherHandler catches the exceptions selected by a Matcher
+type MatcherHandler struct {
+	matcher Matcher
+	handler func(Exception)
+}
+
+func (mh *MatcherHandler) Handle(ex Exception) bool {
+	if !mh.Matches(ex) {
+		return false
+	}
+	mh.handler(ex)
+	return true
+}
+
+// Matches reports whether the handler catches ex, without running it
+func (mh *MatcherHandler) Matches(ex Exception) bool {
+	return mh.matcher.Match(&ex)
+}
+
+// HandlerName describes the handler in reports, using the matcher's String method
+// when it has one
+func (mh *MatcherHandler) HandlerName() string {
+	if named, ok := mh.matcher.(interface{ String() string }); ok {
+		return "HandlerMatch[" + named.String() + "]"
+	}
+	return "HandlerMatch"
+}
+
+// HandlerMatch creates a handler for the exceptions selected by matcher
+func HandlerMatch(matcher Matcher, handler func(Exception)) ExceptionHandler {
+	return &MatcherHandler{matcher: matcher, handler: handler}
+}
@@ -0,0 +1,93 @@
+package goexceptions
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// ============================================================================
+// MULTI-STATUS RESPONSES: Per-item outcomes for batch HTTP endpoints
+// ============================================================================
+
+// ItemIDKey identifies the batch item an exception belongs to in multi-status payloads
+var ItemIDKey = DataKey[string]{Name: "item_id"}
+
+// MultiStatusItem is the outcome of one item of a batch request
+type MultiStatusItem struct {
+	ID      string `json:"id"`
+	Status  int    `json:"status"`
+	Code    string `json:"code,omitempty"`    // Code field of the exception, or its TypeName
+	Message string `json:"message,omitempty"` // empty for succeeded items
+}
+
+// MultiStatus is a standardized payload for batch endpoints. Status is 207 when any
+// item failed, 200 otherwise.
+type MultiStatus struct {
+	Status int               `json:"status"`
+	Items  []MultiStatusItem `json:"items"`
+}
+
+// MultiStatusFromAggregate builds the payload for the failures of an
+// AggregateException, one item each, with the status chosen by HTTPStatusFor. Items
+// are identified by ItemIDKey, or by their position. Any other exception becomes a
+// single item.
+func MultiStatusFromAggregate(ex *Exception) MultiStatus {
+	failures := []*Exception{ex}
+	if aggregate, ok := ex.Type.(AggregateException); ok {
+		failures = aggregate.Exceptions
+	}
+
+	ms := MultiStatus{Status: http.StatusOK, Items: make([]MultiStatusItem, 0, len(failures))}
+	for i, failure := range failures {
+		id, ok := GetTyped(failure, ItemIDKey)
+		if !ok {
+			id = strconv.Itoa(i)
+		}
+		ms.add(failedItem(id, failure))
+	}
+	return ms
+}
+
+// MultiStatus builds the payload for a file batch, identifying items by path.
+// Pending files, which were never attempted, get 424 Failed Dependency.
+func (r *FileBatchResult) MultiStatus() MultiStatus {
+	ms := MultiStatus{Status: http.StatusOK}
+	for _, path := range r.Succeeded {
+		ms.add(MultiStatusItem{ID: path, Status: http.StatusOK})
+	}
+	for _, failure := range r.Failures {
+		ms.add(failedItem(failure.Path, failure.Exception))
+	}
+	for _, path := range r.Pending {
+		ms.add(MultiStatusItem{ID: path, Status: http.StatusFailedDependency, Message: "not attempted"})
+	}
+	return ms
+}
+
+func (ms *MultiStatus) add(item MultiStatusItem) {
+	if item.Status >= http.StatusBadRequest {
+		ms.Status = http.StatusMultiStatus
+	}
+	ms.Items = append(ms.Items, item)
+}
+
+func failedItem(id string, ex *Exception) MultiStatusItem {
+	return MultiStatusItem{ID: id, Status: HTTPStatusFor(ex), Code: responseCode(ex), Message: ex.Error()}
+}
+
+// responseCode identifies an exception in response payloads: its Code field, or its
+// TypeName
+func responseCode(ex *Exception) string {
+	if code := codeOf(ex.Type); code != "" {
+		return code
+	}
+	return ex.TypeName()
+}
+
+// WriteMultiStatus writes ms as a JSON response with its status code
+func WriteMultiStatus(w http.ResponseWriter, ms MultiStatus) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(ms.Status)
+	return json.NewEncoder(w).Encode(ms)
+}
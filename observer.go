@@ -0,0 +1,186 @@
+package goexceptions
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// OBSERVERS: Hooks notified as exceptions flow through Try blocks
+// ============================================================================
+
+// EventKind describes what happened to an exception
+type EventKind int
+
+const (
+	// EventCaught is emitted when Try captures an exception
+	EventCaught EventKind = iota
+	// EventHandled is emitted when a handler consumes the exception
+	EventHandled
+	// EventUnhandled is emitted when a chain ends (Finally, End, Rethrow) without a handler
+	EventUnhandled
+	// EventDegraded is emitted when the outcome is marked as recovered with reduced functionality
+	EventDegraded
+	// EventHandlerFailed is emitted with a HandlerFailureException when a handler panics
+	EventHandlerFailed
+	// EventDiagnostic is emitted with diagnostic exceptions raised by debug mode checks
+	EventDiagnostic
+	// EventWarning is emitted by Report for problems that did not interrupt execution
+	EventWarning
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventCaught:
+		return "caught"
+	case EventHandled:
+		return "handled"
+	case EventUnhandled:
+		return "unhandled"
+	case EventDegraded:
+		return "degraded"
+	case EventHandlerFailed:
+		return "handler_failed"
+	case EventDiagnostic:
+		return "diagnostic"
+	case EventWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is delivered to observers
+type Event struct {
+	Kind      EventKind
+	Exception *Exception
+	Time      time.Time
+	Name      string          // operation name given with WithName
+	Policy    ExceptionPolicy // policy in effect for this Try
+	Reason    string          // degradation reason for EventDegraded
+
+	IdempotencyKey string // key given with WithIdempotencyKey
+	Occurrences    int    // events this one stands for, including suppressed duplicates
+	Tenant         string // tenant given with WithTenant or extracted from the context
+
+	Context context.Context // context given with WithContext, or context.Background
+}
+
+// Observer receives exception events
+type Observer interface {
+	Observe(event Event)
+}
+
+// ObserverFunc adapts a function to the Observer interface
+type ObserverFunc func(event Event)
+
+func (f ObserverFunc) Observe(event Event) {
+	f(event)
+}
+
+type observerEntry struct {
+	observer Observer
+}
+
+var observersMutex sync.RWMutex
+var observers []*observerEntry
+
+// AddObserver registers a global observer and returns a function that removes it
+func AddObserver(observer Observer) (remove func()) {
+	entry := &observerEntry{observer: observer}
+
+	observersMutex.Lock()
+	observers = append(observers, entry)
+	observersMutex.Unlock()
+
+	return func() {
+		observersMutex.Lock()
+		defer observersMutex.Unlock()
+		for i, existing := range observers {
+			if existing == entry {
+				observers = append(observers[:i:i], observers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// notify delivers an event to the global observers and the Try's own observer.
+// A panicking observer never interrupts the exception flow that triggered it.
+func (tr *TryResult) notify(kind EventKind) {
+	tr.emit(kind, tr.exception, "")
+}
+
+// emit delivers an event about ex, which is usually but not always the captured exception
+func (tr *TryResult) emit(kind EventKind, ex *Exception, reason string) {
+	if observersOff.Load() {
+		return
+	}
+	observersMutex.RLock()
+	current := observers
+	observersMutex.RUnlock()
+
+	if len(current) == 0 && tr.config.observer == nil {
+		return
+	}
+
+	now := time.Now()
+	tenant := tr.Tenant()
+	admitted, occurrences := admitEvent(kind, tenant, tr.config.idempotencyKey, ex, now)
+	if !admitted || !admitTenant(tenant, now) {
+		return
+	}
+
+	event := Event{
+		Kind:           kind,
+		Exception:      ex,
+		Time:           now,
+		Name:           tr.config.name,
+		Policy:         tr.Policy(),
+		Reason:         reason,
+		IdempotencyKey: tr.config.idempotencyKey,
+		Occurrences:    occurrences,
+		Tenant:         tenant,
+		Context:        tr.Context(),
+	}
+	for _, entry := range current {
+		deliver(entry.observer, event)
+	}
+	if tr.config.observer != nil {
+		deliver(tr.config.observer, event)
+	}
+}
+
+func deliver(observer Observer, event Event) {
+	defer func() { recover() }()
+	observer.Observe(event)
+}
+
+// markHandled records that a handler consumed the exception
+func (tr *TryResult) markHandled(by handlerRef) {
+	tr.handled = true
+	tr.handledBy = by
+	tr.notify(EventHandled)
+}
+
+// complete ends the chain, reporting the exception as unhandled at most once
+func (tr *TryResult) complete() {
+	if tr.completed {
+		return
+	}
+	tr.completed = true
+	tr.runDeferred()
+	if tr.exception != nil && !tr.handled {
+		tr.notify(EventUnhandled)
+	}
+	tr.repanicPassthrough()
+}
+
+// notifyException reports an exception raised outside of a user Try block,
+// such as a failure detected by one of the package's own runners
+func notifyException(kind EventKind, ex *Exception, name string) {
+	tr := newTryResult([]TryOption{WithName(name)})
+	tr.exception = ex
+	tr.notify(kind)
+}
@@ -0,0 +1,92 @@
+package goexceptions
+
+import "context"
+
+// ============================================================================
+// TRY OPTIONS: Per-call configuration without Try variants
+// ============================================================================
+
+// TryOption configures a single Try call
+type TryOption func(*tryConfig)
+
+// tryConfig is stored inline in TryResult so calls without options allocate nothing extra
+type tryConfig struct {
+	name           string
+	ctx            context.Context
+	noStack        bool
+	observer       Observer
+	policy         *ExceptionPolicy
+	idempotencyKey string
+	tenant         string
+	leakCheck      bool
+	strictSet      bool
+	strict         bool
+}
+
+// WithName names the operation; the name is attached to observer events
+func WithName(name string) TryOption {
+	return func(c *tryConfig) {
+		c.name = name
+	}
+}
+
+// WithContext associates a context with the Try, available through TryResult.Context
+func WithContext(ctx context.Context) TryOption {
+	return func(c *tryConfig) {
+		c.ctx = ctx
+	}
+}
+
+// WithoutStack drops stack traces from the captured exception; its Origin is kept
+func WithoutStack() TryOption {
+	return func(c *tryConfig) {
+		c.noStack = true
+	}
+}
+
+// WithObserver notifies observer of this Try's events, in addition to global observers
+func WithObserver(observer Observer) TryOption {
+	return func(c *tryConfig) {
+		c.observer = observer
+	}
+}
+
+// WithPolicy overrides the registered policy for the exception captured by this Try
+func WithPolicy(policy ExceptionPolicy) TryOption {
+	return func(c *tryConfig) {
+		c.policy = &policy
+	}
+}
+
+// Name returns the operation name given with WithName
+func (tr *TryResult) Name() string {
+	if tr == nil {
+		return ""
+	}
+	return tr.config.name
+}
+
+// Context returns the context given with WithContext, or context.Background()
+func (tr *TryResult) Context() context.Context {
+	if tr == nil || tr.config.ctx == nil {
+		return context.Background()
+	}
+	return tr.config.ctx
+}
+
+// Context returns the context of the Try that caught the exception (see
+// WithContext and TryCtx), or context.Background()
+func (e Exception) Context() context.Context {
+	return e.owner.Context()
+}
+
+// Policy returns the policy in effect for the captured exception
+func (tr *TryResult) Policy() ExceptionPolicy {
+	if tr == nil {
+		return ExceptionPolicy{}
+	}
+	if tr.config.policy != nil {
+		return *tr.config.policy
+	}
+	return PolicyFor(tr.exception)
+}
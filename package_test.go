@@ -2,7 +2,6 @@ package goexceptions
 
 import (
 	"errors"
-	"reflect"
 	"strings"
 	"testing"
 )
@@ -17,7 +16,7 @@ func TestPackageInternals(t *testing.T) {
 		// This test has access to package internals
 
 		// Clear cache first
-		typeCache = make(map[reflect.Type]bool)
+		typeCache = make(map[typePair]bool)
 
 		// Test caching behavior
 		for i := 0; i < 10; i++ {
@@ -38,6 +37,31 @@ func TestPackageInternals(t *testing.T) {
 		t.Logf("Type cache has %d entries", len(typeCache))
 	})
 
+	t.Run("Type cache does not poison later matches", func(t *testing.T) {
+		typeCache = make(map[typePair]bool)
+
+		// First lookup for ArgumentNullException is a mismatch
+		Try(func() {
+			ThrowInvalidOperation("first")
+		}).Handle(
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {}),
+			HandlerAny(func(ex Exception) {}),
+		)
+
+		var caught bool
+		Try(func() {
+			ThrowArgumentNull("param", "second")
+		}).Handle(
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {
+				caught = true
+			}),
+		)
+
+		if !caught {
+			t.Error("A cached mismatch must not prevent a later match")
+		}
+	})
+
 	t.Run("Exception wrapper creation", func(t *testing.T) {
 		// Test internal exception wrapper functionality
 		ex := ArgumentNullException{
@@ -65,13 +89,53 @@ func TestPackageInternals(t *testing.T) {
 	})
 }
 
+func TestPreloadWarmup(t *testing.T) {
+	typeCache = make(map[typePair]bool)
+	preloadedTypes = nil
+
+	Preload[ArgumentNullException]()
+	Preload[InvalidOperationException]()
+	PreloadTypes(NetworkException{}, FileException{})
+	PreloadTypes(NetworkException{}) // duplicates are ignored
+
+	if len(preloadedTypes) != 4 {
+		t.Fatalf("Expected 4 preloaded types, got %d", len(preloadedTypes))
+	}
+
+	writes := typeCacheWrites.Load()
+
+	for i := 0; i < 4; i++ {
+		Try(func() {
+			switch i {
+			case 0:
+				ThrowArgumentNull("param", "warm")
+			case 1:
+				ThrowInvalidOperation("warm")
+			case 2:
+				ThrowNetworkError("url", "warm", nil)
+			default:
+				ThrowFileError("file", "warm", nil)
+			}
+		}).Handle(
+			Handler[NetworkException](func(ex NetworkException, full Exception) {}),
+			Handler[FileException](func(ex FileException, full Exception) {}),
+			Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {}),
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {}),
+		)
+	}
+
+	if after := typeCacheWrites.Load(); after != writes {
+		t.Errorf("Expected no cache writes after warm-up, got %d", after-writes)
+	}
+}
+
 // ============================================================================
 // BENCHMARK TESTS FOR PACKAGE PERFORMANCE
 // ============================================================================
 
 func BenchmarkTypeCache(b *testing.B) {
 	// Clear cache
-	typeCache = make(map[reflect.Type]bool)
+	typeCache = make(map[typePair]bool)
 
 	b.ResetTimer()
 
@@ -89,7 +153,7 @@ func BenchmarkTypeCache(b *testing.B) {
 func BenchmarkWithoutCache(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		// Clear cache each time to simulate no caching
-		typeCache = make(map[reflect.Type]bool)
+		typeCache = make(map[typePair]bool)
 
 		Try(func() {
 			ThrowArgumentNull("param", "test")
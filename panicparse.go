@@ -0,0 +1,152 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// PANIC PARSING: Classify runtime and library panic messages
+// ============================================================================
+
+// IndexOutOfRangeException is raised by an index or slice expression outside of
+// the bounds of a sequence. Index and Length are -1 when the message omits them.
+type IndexOutOfRangeException struct {
+	Index   int
+	Length  int // length or capacity the index was checked against
+	Message string
+}
+
+func (e IndexOutOfRangeException) Error() string {
+	return fmt.Sprintf("IndexOutOfRangeException: Index %d is out of range for length %d. %s", e.Index, e.Length, e.Message)
+}
+
+func (e IndexOutOfRangeException) TypeName() string {
+	return "IndexOutOfRangeException"
+}
+
+// NilReferenceException is raised by a nil pointer dereference, a write to a nil
+// map, or a method call on a zero reflect.Value
+type NilReferenceException struct {
+	Address uintptr // faulting address, when the runtime reports it
+	Message string
+}
+
+func (e NilReferenceException) Error() string {
+	return fmt.Sprintf("NilReferenceException: %s", e.Message)
+}
+
+func (e NilReferenceException) TypeName() string {
+	return "NilReferenceException"
+}
+
+// DivideByZeroException is raised by an integer division by zero
+type DivideByZeroException struct {
+	Message string
+}
+
+func (e DivideByZeroException) Error() string {
+	return fmt.Sprintf("DivideByZeroException: %s", e.Message)
+}
+
+func (e DivideByZeroException) TypeName() string {
+	return "DivideByZeroException"
+}
+
+// TypeAssertionException is raised by a failed type assertion
+type TypeAssertionException struct {
+	Interface string // static type of the asserted value
+	Concrete  string // dynamic type found, "nil" for a nil interface
+	Asserted  string // type or interface asserted
+	Missing   string // missing method, when asserting an interface
+	Message   string
+}
+
+func (e TypeAssertionException) Error() string {
+	return fmt.Sprintf("TypeAssertionException: %s", e.Message)
+}
+
+func (e TypeAssertionException) TypeName() string {
+	return "TypeAssertionException"
+}
+
+var (
+	indexPattern         = regexp.MustCompile(`index out of range(?: \[(-?\d+)\] with length (\d+))?`)
+	sliceBoundsPattern   = regexp.MustCompile(`slice bounds out of range(?: \[([^\]]*)\](?: with (?:length|capacity) (\d+))?)?`)
+	typeAssertionPattern = regexp.MustCompile(`^interface conversion: (.+?) is (.+?), not (.+)$`)
+	missingMethodPattern = regexp.MustCompile(`^interface conversion: (.+?) is not (.+?): missing method (\S+)$`)
+	firstNumberPattern   = regexp.MustCompile(`-?\d+`)
+)
+
+// ParsePanic classifies a recovered panic value by its message. It recognizes the
+// runtime's index, slice bounds, nil dereference, nil map, division and type
+// assertion failures, and zero reflect.Value calls. It returns false for other
+// values, which Try turns into InvalidOperationException.
+func ParsePanic(value any) (ExceptionType, bool) {
+	var message string
+	switch v := value.(type) {
+	case string:
+		message = v
+	case error:
+		message = v.Error()
+	default:
+		return nil, false
+	}
+	message = strings.TrimPrefix(message, "runtime error: ")
+
+	switch {
+	case strings.Contains(message, "integer divide by zero"):
+		return DivideByZeroException{Message: message}, true
+
+	case strings.Contains(message, "invalid memory address or nil pointer dereference"):
+		ex := NilReferenceException{Message: message}
+		if addr, ok := value.(interface{ Addr() uintptr }); ok {
+			ex.Address = addr.Addr()
+		}
+		return ex, true
+
+	case strings.Contains(message, "assignment to entry in nil map"),
+		strings.HasPrefix(message, "reflect: call of ") && strings.HasSuffix(message, " on zero Value"):
+		return NilReferenceException{Message: message}, true
+	}
+
+	if m := indexPattern.FindStringSubmatch(message); m != nil {
+		return IndexOutOfRangeException{Index: atoiOr(m[1], -1), Length: atoiOr(m[2], -1), Message: message}, true
+	}
+	if m := sliceBoundsPattern.FindStringSubmatch(message); m != nil {
+		return IndexOutOfRangeException{
+			Index:   atoiOr(firstNumberPattern.FindString(m[1]), -1),
+			Length:  atoiOr(m[2], -1),
+			Message: message,
+		}, true
+	}
+
+	if m := missingMethodPattern.FindStringSubmatch(message); m != nil {
+		return TypeAssertionException{Concrete: m[1], Asserted: m[2], Missing: m[3], Message: message}, true
+	}
+	if m := typeAssertionPattern.FindStringSubmatch(message); m != nil {
+		return TypeAssertionException{Interface: m[1], Concrete: m[2], Asserted: m[3], Message: message}, true
+	}
+	return nil, false
+}
+
+// translateRuntimeError classifies runtime and reflect errors recovered from panics
+func translateRuntimeError(err error) (ExceptionType, bool) {
+	switch err.(type) {
+	case runtime.Error, *reflect.ValueError:
+		return ParsePanic(err)
+	}
+	return nil, false
+}
+
+func atoiOr(s string, fallback int) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
@@ -0,0 +1,93 @@
+package goexceptions
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ============================================================================
+// PARSING: Positioned exceptions for file-ingestion pipelines
+// ============================================================================
+
+// ParseException reports malformed input, with its position when known
+type ParseException struct {
+	Line    int // 1-based, 0 when unknown
+	Column  int // 1-based, 0 when unknown
+	Snippet string
+	Message string
+	Cause   error
+}
+
+func (e ParseException) Error() string {
+	msg := fmt.Sprintf("ParseException: %s", e.Message)
+	if e.Line > 0 {
+		msg += fmt.Sprintf(" (Line: %d, Column: %d)", e.Line, e.Column)
+	}
+	if e.Snippet != "" {
+		msg += fmt.Sprintf(" near %q", e.Snippet)
+	}
+	if e.Cause != nil {
+		msg += fmt.Sprintf(" (Cause: %v)", e.Cause)
+	}
+	return msg
+}
+
+func (e ParseException) TypeName() string {
+	return "ParseException"
+}
+
+func ThrowParseError(line, column int, snippet, message string, cause error) {
+	Throw(ParseException{Line: line, Column: column, Snippet: snippet, Message: message, Cause: cause})
+}
+
+// ThrowIfScanError throws a ParseException for a bufio.Scanner failure, using the
+// line counted by the caller since the scanner does not track positions. Errors of
+// the underlying reader are thrown as an IOException instead.
+func ThrowIfScanError(scanner *bufio.Scanner, line int) {
+	err := scanner.Err()
+	if err == nil {
+		return
+	}
+	ex, ok := translateParseError(err)
+	parseEx, isParse := ex.(ParseException)
+	if !ok || !isParse {
+		Throw(IOException{Operation: "read", Message: fmt.Sprintf("scan failed at line %d", line), Cause: err})
+	}
+	parseEx.Line = line
+	Throw(parseEx)
+}
+
+// translateParseError recognizes csv, strconv and bufio scanner errors
+func translateParseError(err error) (ExceptionType, bool) {
+	var csvErr *csv.ParseError
+	if errors.As(err, &csvErr) {
+		return ParseException{
+			Line:    csvErr.Line,
+			Column:  csvErr.Column,
+			Message: csvErr.Err.Error(),
+			Cause:   err,
+		}, true
+	}
+
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		return ParseException{
+			Snippet: numErr.Num,
+			Message: fmt.Sprintf("%s: %v", numErr.Func, numErr.Err),
+			Cause:   err,
+		}, true
+	}
+
+	switch {
+	case errors.Is(err, bufio.ErrTooLong),
+		errors.Is(err, bufio.ErrNegativeAdvance),
+		errors.Is(err, bufio.ErrAdvanceTooFar),
+		errors.Is(err, bufio.ErrBadReadCount):
+		return ParseException{Message: "scan failed", Cause: err}, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,112 @@
+package goexceptions
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// PANIC PASSTHROUGH: Panic values that must never become exceptions
+// ============================================================================
+
+// passthroughEntry matches either a panic value or a panic type
+type passthroughEntry struct {
+	value any
+	typ   reflect.Type
+}
+
+var passthroughMutex sync.RWMutex
+var passthroughs []*passthroughEntry
+
+// RegisterPassthrough makes Try leave panics with value untouched, for frameworks
+// that rely on specific panic values such as http.ErrAbortHandler. Errors match with
+// errors.Is, other values with ==. It returns a function that removes the entry.
+//
+// Try recovers the value without converting it and panics again with it, after the
+// cleanups registered with Defer ran, at the first handler or Else call, or when the
+// chain ends (Finally, End, Rethrow). Handlers and Else never run; Finally runs its
+// cleanup first when no handler call precedes it.
+func RegisterPassthrough(value any) (remove func()) {
+	return addPassthrough(&passthroughEntry{value: value})
+}
+
+// RegisterPassthroughType is RegisterPassthrough for every panic value of type T,
+// or implementing T when it is an interface
+func RegisterPassthroughType[T any]() (remove func()) {
+	return addPassthrough(&passthroughEntry{typ: getTypeOf[T]()})
+}
+
+func addPassthrough(entry *passthroughEntry) (remove func()) {
+	passthroughMutex.Lock()
+	passthroughs = append(passthroughs, entry)
+	passthroughMutex.Unlock()
+
+	return func() {
+		passthroughMutex.Lock()
+		defer passthroughMutex.Unlock()
+		for i, existing := range passthroughs {
+			if existing == entry {
+				passthroughs = append(passthroughs[:i:i], passthroughs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// isPassthrough reports whether a recovered panic value must be left untouched
+func isPassthrough(r any) bool {
+	passthroughMutex.RLock()
+	current := passthroughs
+	passthroughMutex.RUnlock()
+
+	for _, entry := range current {
+		if entry.matches(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *passthroughEntry) matches(r any) bool {
+	actual := reflect.TypeOf(r)
+	if p.typ != nil {
+		if p.typ.Kind() == reflect.Interface {
+			return actual.Implements(p.typ)
+		}
+		return actual == p.typ
+	}
+
+	if target, ok := p.value.(error); ok {
+		if err, ok := r.(error); ok {
+			return errors.Is(err, target)
+		}
+	}
+	// Comparable checks the dynamic values too: a comparable struct can still hold
+	// an interface with a slice, which would make == panic
+	return actual == reflect.TypeOf(p.value) && reflect.ValueOf(r).Comparable() &&
+		reflect.ValueOf(p.value).Comparable() && r == p.value
+}
+
+// passThrough ends the chain when Try recovered a passthrough value, panicking again
+// with it. Handler calls start with it, so a chain without Finally or End does not
+// swallow the value.
+func (tr *TryResult) passThrough() {
+	if tr != nil && tr.passthrough != nil {
+		tr.complete()
+	}
+}
+
+// settle returns the captured exception for runners that inspect it without ending
+// the chain, first panicking again with a passthrough value
+func (tr *TryResult) settle() *Exception {
+	tr.repanicPassthrough()
+	return tr.exception
+}
+
+func (tr *TryResult) repanicPassthrough() {
+	if r := tr.passthrough; r != nil {
+		tr.passthrough = nil
+		panic(r)
+	}
+}
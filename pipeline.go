@@ -0,0 +1,94 @@
+package goexceptions
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// PIPELINES: Sequential steps sharing a latency budget
+// ============================================================================
+
+// PipelineStep is one step of a Pipeline
+type PipelineStep struct {
+	Name   string
+	Weight float64 // share of the remaining budget, relative to the following steps
+	Run    func(ctx context.Context)
+}
+
+// Pipeline runs steps in order within an overall latency budget, split across the
+// steps by weight. Time a step leaves unused goes to the following steps.
+type Pipeline struct {
+	Name   string
+	Budget time.Duration
+	Steps  []PipelineStep
+}
+
+// NewPipeline returns an empty pipeline with an overall budget
+func NewPipeline(name string, budget time.Duration) *Pipeline {
+	return &Pipeline{Name: name, Budget: budget}
+}
+
+// Step appends a step. Weights of zero or less count as 1.
+func (p *Pipeline) Step(name string, weight float64, run func(ctx context.Context)) *Pipeline {
+	p.Steps = append(p.Steps, PipelineStep{Name: name, Weight: weight, Run: run})
+	return p
+}
+
+// Run runs the steps in order. Each step gets a context whose deadline is its slice:
+// the remaining budget times its weight over the weight of the remaining steps,
+// capped by the deadline of ctx. A step still running past its slice throws a
+// TimeoutException whose Operation is "pipeline/step", with the exception the step
+// threw, if any, as inner exception. Other exceptions propagate unchanged.
+//
+//	NewPipeline("checkout", 800*time.Millisecond).
+//	    Step("reserve", 1, reserve).
+//	    Step("charge", 2, charge).
+//	    Run(ctx)
+func (p *Pipeline) Run(ctx context.Context) {
+	deadline := time.Now().Add(p.Budget)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	remainingWeight := 0.0
+	for _, step := range p.Steps {
+		remainingWeight += stepWeight(step)
+	}
+	for _, step := range p.Steps {
+		weight := stepWeight(step)
+		slice := time.Duration(float64(time.Until(deadline)) * weight / remainingWeight)
+		remainingWeight -= weight
+		p.runStep(ctx, step, slice)
+	}
+}
+
+func (p *Pipeline) runStep(ctx context.Context, step PipelineStep, slice time.Duration) {
+	stepCtx, cancel := context.WithTimeout(ctx, max(slice, 0))
+	defer cancel()
+
+	start := time.Now()
+	ex := Try(func() {
+		step.Run(stepCtx)
+	}, WithContext(stepCtx)).settle()
+
+	elapsed := time.Since(start)
+	if elapsed > slice && ctx.Err() == nil {
+		ThrowWithInner(TimeoutException{
+			Operation: p.Name + "/" + step.Name,
+			Timeout:   slice,
+			Elapsed:   elapsed,
+			Message:   "step exceeded its share of the pipeline budget",
+		}, ex)
+	}
+	if ex != nil {
+		panic(*ex)
+	}
+}
+
+func stepWeight(step PipelineStep) float64 {
+	if step.Weight <= 0 {
+		return 1
+	}
+	return step.Weight
+}
@@ -0,0 +1,71 @@
+package goexceptions
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+)
+
+// ============================================================================
+// PKG/ERRORS INTEROP: Reuse stacks recorded by github.com/pkg/errors
+// ============================================================================
+
+// StackTraceOf returns the stack recorded by github.com/pkg/errors (or any error
+// with a compatible StackTrace method) in err's chain, formatted like
+// Exception.StackTrace. The deepest recorded stack is used, since it is the closest
+// to the original failure. It returns nil when no error in the chain has one.
+//
+// The package is detected structurally, so go-exceptions does not depend on it.
+// Errors translated by ThrowIfError or recovered from panics use this stack
+// instead of the stack of the throw.
+func StackTraceOf(err error) []string {
+	var frames []string
+	for err != nil {
+		if stack := pkgErrorsStack(err); stack != nil {
+			frames = stack
+		}
+		err = nextCause(err)
+	}
+	return frames
+}
+
+// nextCause follows Unwrap, or Cause for pkg/errors versions predating Unwrap
+func nextCause(err error) error {
+	if next := errors.Unwrap(err); next != nil {
+		return next
+	}
+	if causer, ok := err.(interface{ Cause() error }); ok {
+		if next := causer.Cause(); next != err {
+			return next
+		}
+	}
+	return nil
+}
+
+// pkgErrorsStack calls a StackTrace method returning a slice of program counters,
+// which is how pkg/errors represents errors.StackTrace ([]Frame, Frame uintptr)
+func pkgErrorsStack(err error) []string {
+	method := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil
+	}
+	resultType := method.Type().Out(0)
+	if resultType.Kind() != reflect.Slice || resultType.Elem().Kind() != reflect.Uintptr {
+		return nil
+	}
+
+	stack := method.Call(nil)[0]
+	frames := make([]string, 0, stack.Len())
+	for i := 0; i < stack.Len(); i++ {
+		// pkg/errors stores return addresses; the call is the instruction before
+		pc := uintptr(stack.Index(i).Uint()) - 1
+		fn := runtime.FuncForPC(pc)
+		if fn == nil {
+			continue
+		}
+		file, line := fn.FileLine(pc)
+		frames = append(frames, fmt.Sprintf("%s:%d %s", file, line, fn.Name()))
+	}
+	return frames
+}
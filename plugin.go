@@ -0,0 +1,134 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+)
+
+// ============================================================================
+// PLUGIN BRIDGING: Call reflected plugin symbols without letting panics through
+// ============================================================================
+
+// PluginException is thrown when a plugin function panics. The panic, converted to
+// an exception, is its inner exception.
+type PluginException struct {
+	Function string
+	Message  string
+}
+
+func (e PluginException) Error() string {
+	return fmt.Sprintf("PluginException: plugin function '%s' failed. %s", e.Function, e.Message)
+}
+
+func (e PluginException) TypeName() string {
+	return "PluginException"
+}
+
+// PluginArgumentException is thrown when a plugin symbol cannot be called with the
+// given arguments. Index is the offending argument, or -1 when the symbol itself or
+// the argument count is wrong.
+type PluginArgumentException struct {
+	Function string
+	Index    int
+	Expected string
+	Actual   string
+	Message  string
+}
+
+func (e PluginArgumentException) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("PluginArgumentException: cannot call '%s'. %s", e.Function, e.Message)
+	}
+	return fmt.Sprintf("PluginArgumentException: argument %d of '%s' must be %s, got %s. %s",
+		e.Index, e.Function, e.Expected, e.Actual, e.Message)
+}
+
+func (e PluginArgumentException) TypeName() string {
+	return "PluginArgumentException"
+}
+
+// CallPlugin calls fn, typically a symbol returned by plugin.Lookup, with args and
+// returns its results. A pointer to a function, as Lookup returns for function
+// variables, is dereferenced first.
+//
+// Arguments are checked before the call: a wrong count or type throws
+// PluginArgumentException instead of the reflect panic. Any panic raised by the
+// plugin is converted like Try does and thrown as the inner exception of a
+// PluginException, so the host can handle it like its own failures.
+func CallPlugin(fn reflect.Value, args ...any) []any {
+	if fn.IsValid() && fn.Kind() == reflect.Pointer && !fn.IsNil() && fn.Elem().Kind() == reflect.Func {
+		fn = fn.Elem()
+	}
+	if !fn.IsValid() || (fn.Kind() == reflect.Func || fn.Kind() == reflect.Pointer) && fn.IsNil() {
+		ThrowArgumentNull("fn", "plugin symbol is nil")
+	}
+	if fn.Kind() != reflect.Func {
+		Throw(PluginArgumentException{Function: fn.Type().String(), Index: -1, Message: "symbol is not a function"})
+	}
+
+	name := pluginFuncName(fn)
+	inputs := pluginInputs(name, fn.Type(), args)
+
+	var results []reflect.Value
+	ex := Try(func() {
+		results = fn.Call(inputs)
+	}, WithName(name)).settle()
+	if ex != nil {
+		ThrowWithInner(PluginException{Function: name, Message: ex.Error()}, ex)
+	}
+
+	values := make([]any, len(results))
+	for i, result := range results {
+		values[i] = result.Interface()
+	}
+	return values
+}
+
+func pluginFuncName(fn reflect.Value) string {
+	if f := runtime.FuncForPC(fn.Pointer()); f != nil {
+		return f.Name()
+	}
+	return fn.Type().String()
+}
+
+// pluginInputs validates args against the parameters of fnType
+func pluginInputs(name string, fnType reflect.Type, args []any) []reflect.Value {
+	fixed := fnType.NumIn()
+	if fnType.IsVariadic() {
+		fixed--
+	}
+	if len(args) < fixed || !fnType.IsVariadic() && len(args) > fixed {
+		Throw(PluginArgumentException{
+			Function: name,
+			Index:    -1,
+			Message:  fmt.Sprintf("expected %d arguments, got %d", fnType.NumIn(), len(args)),
+		})
+	}
+
+	inputs := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var param reflect.Type
+		if i < fixed {
+			param = fnType.In(i)
+		} else {
+			param = fnType.In(fixed).Elem()
+		}
+
+		if arg == nil {
+			switch param.Kind() {
+			case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+				inputs[i] = reflect.Zero(param)
+				continue
+			}
+			Throw(PluginArgumentException{Function: name, Index: i, Expected: param.String(), Actual: "nil"})
+		}
+
+		value := reflect.ValueOf(arg)
+		if !value.Type().AssignableTo(param) {
+			Throw(PluginArgumentException{Function: name, Index: i, Expected: param.String(), Actual: value.Type().String()})
+		}
+		inputs[i] = value
+	}
+	return inputs
+}
@@ -0,0 +1,116 @@
+package goexceptions
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// POLICY REGISTRY: Per-type operational classification
+// ============================================================================
+
+// SLOCategory classifies which service level objective an exception burns
+type SLOCategory int
+
+const (
+	SLONone SLOCategory = iota
+	SLOAvailability
+	SLOLatency
+	SLOCorrectness
+)
+
+func (c SLOCategory) String() string {
+	switch c {
+	case SLOAvailability:
+		return "availability"
+	case SLOLatency:
+		return "latency"
+	case SLOCorrectness:
+		return "correctness"
+	default:
+		return "none"
+	}
+}
+
+// ExceptionPolicy describes how an exception type is treated operationally
+type ExceptionPolicy struct {
+	SLO       SLOCategory
+	Retryable bool // transient failure: retrying or falling back may succeed
+	Reload    bool // a retry must first reload the state the operation read
+}
+
+var policyMutex sync.RWMutex
+var policies = make(map[reflect.Type]ExceptionPolicy)
+
+// defaultPolicies apply to built-in types unless a policy is registered
+var defaultPolicies = map[reflect.Type]ExceptionPolicy{
+	reflect.TypeOf(NetworkException{}):     {SLO: SLOAvailability, Retryable: true},
+	reflect.TypeOf(ConcurrencyException{}): {Retryable: true, Reload: true},
+}
+
+// RegisterPolicy sets the policy for exceptions of type T
+func RegisterPolicy[T ExceptionType](policy ExceptionPolicy) {
+	checkTypeNameOf[T]()
+	policyMutex.Lock()
+	defer policyMutex.Unlock()
+	policies[getTypeOf[T]()] = policy
+}
+
+// UnregisterPolicy removes the policy for exceptions of type T, restoring its default
+func UnregisterPolicy[T ExceptionType]() {
+	policyMutex.Lock()
+	defer policyMutex.Unlock()
+	delete(policies, getTypeOf[T]())
+}
+
+// PolicyFor returns the policy registered for the exception's type, its built-in
+// default, or the zero policy
+func PolicyFor(ex *Exception) ExceptionPolicy {
+	if ex == nil || ex.Type == nil {
+		return ExceptionPolicy{}
+	}
+	exceptionType := reflect.TypeOf(ex.Type)
+
+	policyMutex.RLock()
+	defer policyMutex.RUnlock()
+	if policy, exists := policies[exceptionType]; exists {
+		return policy
+	}
+	return defaultPolicies[exceptionType]
+}
+
+// IsRetryable reports whether the exception's policy marks it as transient
+func IsRetryable(ex *Exception) bool {
+	return PolicyFor(ex).Retryable
+}
+
+// ============================================================================
+// SLO BURN HOOK
+// ============================================================================
+
+// SLOBurnEvent is emitted when a handled or unhandled exception counts against an SLO
+type SLOBurnEvent struct {
+	Category  SLOCategory
+	Handled   bool
+	Exception *Exception
+}
+
+// SLOObserver returns an observer that maps handled and unhandled exceptions to
+// their SLO category through the policy in effect (the policy registry unless
+// overridden with WithPolicy) and calls burn for each one.
+// Exceptions without an SLO category are ignored.
+func SLOObserver(burn func(SLOBurnEvent)) Observer {
+	return ObserverFunc(func(event Event) {
+		if event.Kind != EventHandled && event.Kind != EventUnhandled {
+			return
+		}
+		if event.Policy.SLO == SLONone {
+			return
+		}
+		burn(SLOBurnEvent{
+			Category:  event.Policy.SLO,
+			Handled:   event.Kind == EventHandled,
+			Exception: event.Exception,
+		})
+	})
+}
@@ -0,0 +1,88 @@
+package goexceptions
+
+import "time"
+
+// ============================================================================
+// POLICY WRAP: Resilience policies composed around one block
+// ============================================================================
+
+// Policy runs a block under a resilience strategy, such as retrying it or bounding
+// its run time. Exceptions a policy does not absorb propagate unchanged, so the
+// innermost exception chain survives any number of layers. CircuitBreaker is a
+// Policy; RetryPolicy, TimeoutPolicy and FallbackPolicy adapt the other strategies.
+type Policy interface {
+	Run(block func())
+}
+
+// PolicyFunc adapts a function to a Policy
+type PolicyFunc func(block func())
+
+func (f PolicyFunc) Run(block func()) {
+	f(block)
+}
+
+// RetryPolicy runs blocks with Retry
+func RetryPolicy(opts ...RetryOption) Policy {
+	return PolicyFunc(func(block func()) {
+		Retry(block, opts...)
+	})
+}
+
+// TimeoutPolicy runs blocks with TryWithTimeout, throwing its TimeoutException
+func TimeoutPolicy(d time.Duration, opts ...TryOption) Policy {
+	return PolicyFunc(func(block func()) {
+		if ex := TryWithTimeout(d, block, opts...).settle(); ex != nil {
+			panic(*ex)
+		}
+	})
+}
+
+// FallbackPolicy runs fallback instead of failing when a block throws, as Fallback
+// does
+func FallbackPolicy(fallback func(Exception), opts ...FallbackOption) Policy {
+	return PolicyFunc(func(block func()) {
+		Fallback(func() struct{} {
+			block()
+			return struct{}{}
+		}, func(ex Exception) struct{} {
+			fallback(ex)
+			return struct{}{}
+		}, opts...)
+	})
+}
+
+// PolicyWrap is a Policy made of others, the first one outermost
+type PolicyWrap struct {
+	policies []Policy
+}
+
+// Wrap composes policies, the first one outermost. A usual order is fallback,
+// retry, circuit breaker, then timeout, so that each attempt is time-boxed, the
+// breaker sees every attempt, and the fallback applies once retrying gave up:
+//
+//	resilient := Wrap(
+//	    FallbackPolicy(serveCached, FallbackOn[RetryExhaustedException](), FallbackOn[CircuitOpenException]()),
+//	    RetryPolicy(WithMaxAttempts(3)),
+//	    breaker,
+//	    TimeoutPolicy(time.Second),
+//	)
+//	resilient.Try(fetchPrices).Any(logIt).End()
+func Wrap(policies ...Policy) *PolicyWrap {
+	return &PolicyWrap{policies: policies}
+}
+
+// Run runs block through every policy
+func (pw *PolicyWrap) Run(block func()) {
+	for i := len(pw.policies) - 1; i >= 0; i-- {
+		policy, inner := pw.policies[i], block
+		block = func() { policy.Run(inner) }
+	}
+	block()
+}
+
+// Try runs block like Try through every policy
+func (pw *PolicyWrap) Try(block func(), opts ...TryOption) *TryResult {
+	return Try(func() {
+		pw.Run(block)
+	}, opts...)
+}
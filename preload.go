@@ -0,0 +1,48 @@
+package goexceptions
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// WARM-UP: Pre-register known exception types at startup
+// ============================================================================
+
+var preloadMutex sync.Mutex
+var preloadedTypes []reflect.Type
+
+// Preload primes the type caches for T so the first throw and catch of T in a
+// latency-sensitive path does not pay for reflection and cache writes. The matches of
+// preloaded types are never evicted from the cache (see SetTypeCacheLimit).
+func Preload[T ExceptionType]() {
+	checkTypeNameOf[T]()
+	preloadType(getTypeOf[T]())
+}
+
+// PreloadTypes primes the type caches for the types of the given sample values
+func PreloadTypes(samples ...ExceptionType) {
+	for _, sample := range samples {
+		checkTypeName(reflect.TypeOf(sample), sample)
+		preloadType(reflect.TypeOf(sample))
+	}
+}
+
+// preloadType records the match result between t and every type preloaded so far,
+// in both directions, which covers every handler/exception pairing among them
+func preloadType(t reflect.Type) {
+	preloadMutex.Lock()
+	defer preloadMutex.Unlock()
+
+	for _, existing := range preloadedTypes {
+		if existing == t {
+			return
+		}
+	}
+	preloadedTypes = append(preloadedTypes, t)
+
+	for _, other := range preloadedTypes {
+		storeWarmTypeMatch(typePair{expected: t, actual: other})
+		storeWarmTypeMatch(typePair{expected: other, actual: t})
+	}
+}
@@ -0,0 +1,43 @@
+package goexceptions
+
+import (
+	"context"
+	"runtime/pprof"
+)
+
+// ============================================================================
+// PROFILER LABELS: Slice CPU and heap profiles by operation
+// ============================================================================
+
+// Profiler label keys set around named Try blocks
+const (
+	// LabelOperation carries the name given with WithName
+	LabelOperation = "operation"
+	// LabelExceptionType carries the type of the exception while its handler runs
+	LabelExceptionType = "exception_type"
+)
+
+// runLabeled runs the block of a named Try with the operation label set. Unnamed
+// Trys run unlabeled, so the common path pays nothing.
+func (tr *TryResult) runLabeled(block func()) {
+	if tr.config.name == "" {
+		block()
+		return
+	}
+	pprof.Do(tr.Context(), pprof.Labels(LabelOperation, tr.config.name), func(context.Context) {
+		block()
+	})
+}
+
+// runHandlerLabeled runs a handler of a named Try with the operation and exception
+// type labels set, so work done on the exceptional path can be told apart
+func (tr *TryResult) runHandlerLabeled(call func()) {
+	if tr.config.name == "" || tr.exception == nil {
+		call()
+		return
+	}
+	labels := pprof.Labels(LabelOperation, tr.config.name, LabelExceptionType, tr.exception.TypeName())
+	pprof.Do(tr.Context(), labels, func(context.Context) {
+		call()
+	})
+}
@@ -0,0 +1,79 @@
+package goexceptions
+
+import (
+	"path"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// REDACTION: Hide sensitive Data values from renderers and reporters
+// ============================================================================
+
+// RedactedValue replaces sensitive Data values whenever they are rendered
+const RedactedValue = "[REDACTED]"
+
+// RedactionFunc decides whether a Data entry is sensitive and what to show instead
+type RedactionFunc func(key string, value interface{}) (replacement interface{}, redact bool)
+
+var redactionMutex sync.RWMutex
+var redactionPatterns []string
+var redactionFuncs []RedactionFunc
+
+// RedactKeys adds case-insensitive key patterns whose values are redacted.
+// Patterns support shell-style wildcards, e.g. "password" or "*token*".
+func RedactKeys(patterns ...string) {
+	redactionMutex.Lock()
+	defer redactionMutex.Unlock()
+	for _, pattern := range patterns {
+		redactionPatterns = append(redactionPatterns, strings.ToLower(pattern))
+	}
+}
+
+// RedactWith adds a custom redaction rule evaluated after the key patterns
+func RedactWith(rule RedactionFunc) {
+	redactionMutex.Lock()
+	defer redactionMutex.Unlock()
+	redactionFuncs = append(redactionFuncs, rule)
+}
+
+// ClearRedactionRules removes every redaction rule
+func ClearRedactionRules() {
+	redactionMutex.Lock()
+	defer redactionMutex.Unlock()
+	redactionPatterns = nil
+	redactionFuncs = nil
+}
+
+// RedactValue applies the redaction rules to a single Data entry
+func RedactValue(key string, value interface{}) interface{} {
+	redactionMutex.RLock()
+	defer redactionMutex.RUnlock()
+
+	lowerKey := strings.ToLower(key)
+	for _, pattern := range redactionPatterns {
+		if matched, _ := path.Match(pattern, lowerKey); matched {
+			return RedactedValue
+		}
+	}
+	for _, rule := range redactionFuncs {
+		if replacement, redact := rule(key, value); redact {
+			return replacement
+		}
+	}
+	return value
+}
+
+// RedactedData returns a copy of Data with the redaction rules applied, and values
+// bounded by SafeValue. Renderers, serializers and reporters use it instead of
+// reading Data directly.
+func (e *Exception) RedactedData() map[string]interface{} {
+	if len(e.Data) == 0 {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(e.Data))
+	for key, value := range e.Data {
+		redacted[key] = SafeValue(RedactValue(key, value))
+	}
+	return redacted
+}
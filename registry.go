@@ -0,0 +1,52 @@
+package goexceptions
+
+import (
+	"encoding/gob"
+	"reflect"
+	"sync"
+)
+
+// ============================================================================
+// TYPE REGISTRY: Rebuild custom exception types from their names
+// ============================================================================
+
+var registeredTypesMutex sync.RWMutex
+var registeredTypes = make(map[string]reflect.Type)
+
+// RegisterExceptionType makes the custom exception type T known by its TypeName, so
+// that exceptions of type T decoded from JSON or gob are rebuilt as T rather than a
+// RemoteException, and MatchNamedType catches them by name before any was thrown.
+// It registers T with encoding/gob as well:
+//
+//	func init() {
+//	    RegisterExceptionType[DatabaseException]()
+//	}
+//
+// A later registration of the same TypeName replaces the type; the collision is
+// reported as by TypeNameCollisions.
+func RegisterExceptionType[T ExceptionType]() {
+	t := getTypeOf[T]()
+	sample := reflect.Zero(t).Interface()
+	if t.Kind() == reflect.Pointer {
+		sample = reflect.New(t.Elem()).Interface()
+	}
+	name, ok := sampleTypeName(sample.(ExceptionType))
+	if !ok {
+		panic("goexceptions: RegisterExceptionType requires TypeName to work on a zero " + t.String())
+	}
+	checkTypeName(t, sample.(ExceptionType))
+
+	registerGobTypes()
+	gob.Register(sample)
+
+	registeredTypesMutex.Lock()
+	defer registeredTypesMutex.Unlock()
+	registeredTypes[name] = t
+}
+
+func registeredTypeNamed(name string) (reflect.Type, bool) {
+	registeredTypesMutex.RLock()
+	defer registeredTypesMutex.RUnlock()
+	t, ok := registeredTypes[name]
+	return t, ok
+}
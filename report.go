@@ -0,0 +1,145 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ============================================================================
+// TRY REPORTS: Structured outcome of a Try for audit logs
+// ============================================================================
+
+// Outcome summarizes how a Try ended
+type Outcome int
+
+const (
+	OutcomeSucceeded Outcome = iota
+	OutcomeHandled
+	OutcomeUnhandled
+	OutcomeDegraded
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeSucceeded:
+		return "succeeded"
+	case OutcomeHandled:
+		return "handled"
+	case OutcomeUnhandled:
+		return "unhandled"
+	case OutcomeDegraded:
+		return "degraded"
+	default:
+		return "unknown"
+	}
+}
+
+// ExceptionSummary is a flat, log-friendly description of an exception.
+// Data is redacted (see RedactKeys); Description and Remediation come from RegisterDoc.
+type ExceptionSummary struct {
+	Type        string
+	Message     string
+	Fingerprint string
+	Origin      string
+	Data        map[string]interface{}
+	Description string
+	Remediation string
+	KnownIssue  string // ticket of the known issue, see RegisterKnownIssue
+}
+
+// TryReport is the structured outcome returned by TryResult.Report
+type TryReport struct {
+	Name           string
+	Outcome        Outcome
+	Duration       time.Duration
+	Handler        string            // handler that matched, "" if none
+	DegradedReason string            // set when Outcome is OutcomeDegraded
+	Exception      *ExceptionSummary // nil when the block succeeded
+	Score          float64           // severity score of the exception, see ScoreFor
+	HandlerFailure *ExceptionSummary // set when the matching handler panicked
+	Resources      *ResourceDelta    // set by WithLeakCheck in debug mode
+	LeakSuspected  bool              // the block threw and left goroutines running
+	Attempts       []Attempt         // timeline of an exhausted retry (see Retry)
+}
+
+// handlerRef identifies the handler that consumed an exception without
+// formatting its name on the hot path
+type handlerRef struct {
+	label   string
+	typ     reflect.Type
+	handler ExceptionHandler
+}
+
+func (h handlerRef) String() string {
+	if h.handler != nil {
+		if named, ok := h.handler.(interface{ HandlerName() string }); ok {
+			return named.HandlerName()
+		}
+		return fmt.Sprintf("%T", h.handler)
+	}
+	if h.typ != nil {
+		return h.label + "[" + h.typ.Name() + "]"
+	}
+	return h.label
+}
+
+// Summarize builds an ExceptionSummary of the exception
+func (e *Exception) Summarize() *ExceptionSummary {
+	summary := &ExceptionSummary{
+		Type:        e.TypeName(),
+		Message:     e.Error(),
+		Fingerprint: e.Fingerprint(),
+		Origin:      e.Origin,
+		Data:        e.RedactedData(),
+	}
+	if doc, ok := DocFor(e); ok {
+		summary.Description = doc.Description
+		summary.Remediation = doc.Remediation
+	}
+	if issue, known := KnownIssueFor(e); known {
+		summary.KnownIssue = issue.Ticket
+	}
+	return summary
+}
+
+// Report returns the structured outcome of the Try
+func (tr *TryResult) Report() TryReport {
+	if tr == nil {
+		return TryReport{}
+	}
+
+	report := TryReport{
+		Name:     tr.config.name,
+		Duration: tr.duration,
+	}
+	switch {
+	case tr.IsDegraded():
+		report.Outcome = OutcomeDegraded
+		report.DegradedReason = tr.degradedReason
+	case tr.exception == nil:
+		report.Outcome = OutcomeSucceeded
+	case tr.handled:
+		report.Outcome = OutcomeHandled
+	default:
+		report.Outcome = OutcomeUnhandled
+	}
+
+	if tr.handled {
+		report.Handler = tr.handledBy.String()
+	}
+	if tr.exception != nil {
+		report.Exception = tr.exception.Summarize()
+		report.Score = score(tr.exception, tr.handled, tr.Policy())
+		report.Attempts = tr.exception.Attempts()
+	}
+	if tr.handlerFailure != nil {
+		report.HandlerFailure = tr.handlerFailure.Summarize()
+	}
+	if tr.resources != nil {
+		resources := *tr.resources
+		report.Resources = &resources
+		report.LeakSuspected = tr.LeakSuspected()
+	}
+	return report
+}
@@ -0,0 +1,151 @@
+package goexceptions
+
+import (
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// RETRY: Repeat transient failures, keeping a timeline of every attempt
+// ============================================================================
+
+// Attempt records one execution of a retried operation
+type Attempt struct {
+	Number    int // 1 for the first attempt
+	Start     time.Time
+	Duration  time.Duration
+	Exception *ExceptionSummary // failure of the attempt, nil if it succeeded
+}
+
+// RetryExhaustedException is thrown when every attempt of a retried operation
+// failed. The last failure is its inner exception.
+type RetryExhaustedException struct {
+	Operation string
+	Attempts  []Attempt
+	Message   string
+}
+
+func (e RetryExhaustedException) Error() string {
+	return fmt.Sprintf("RetryExhaustedException: operation '%s' failed after %d attempts. %s", e.Operation, len(e.Attempts), e.Message)
+}
+
+func (e RetryExhaustedException) TypeName() string {
+	return "RetryExhaustedException"
+}
+
+// Attempts returns the attempt timeline of the first RetryExhaustedException in the
+// exception chain, or nil when the exception does not come from an exhausted retry
+func (e *Exception) Attempts() []Attempt {
+	for current := e; current != nil; current = current.Inner {
+		if exhausted, ok := current.Type.(RetryExhaustedException); ok {
+			return exhausted.Attempts
+		}
+	}
+	return nil
+}
+
+// RetryOption configures Retry and RetryValue
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	name        string
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	retryIf     func(ex *Exception) bool
+}
+
+// WithMaxAttempts sets how many times the operation runs at most (3 by default)
+func WithMaxAttempts(n int) RetryOption {
+	return func(c *retryConfig) {
+		c.maxAttempts = n
+	}
+}
+
+// WithBackoff sets the delay before the second attempt, doubled for each following
+// one up to max (100ms and 5s by default). Zero retries immediately.
+func WithBackoff(initial, max time.Duration) RetryOption {
+	return func(c *retryConfig) {
+		c.backoff = initial
+		c.maxBackoff = max
+	}
+}
+
+// WithRetryIf decides which failures are retried, instead of IsRetryable
+func WithRetryIf(retryIf func(ex *Exception) bool) RetryOption {
+	return func(c *retryConfig) {
+		c.retryIf = retryIf
+	}
+}
+
+// WithRetryName names the operation in observer events and in RetryExhaustedException
+func WithRetryName(name string) RetryOption {
+	return func(c *retryConfig) {
+		c.name = name
+	}
+}
+
+// Retry runs operation until it succeeds, retrying retryable exceptions (see
+// IsRetryable and WithRetryIf). A non-retryable exception is rethrown immediately.
+// When the attempts are exhausted, a RetryExhaustedException carrying the timeline
+// of every attempt is thrown, with the last failure as its inner exception.
+func Retry(operation func(), opts ...RetryOption) {
+	RetryValue(func() struct{} {
+		operation()
+		return struct{}{}
+	}, opts...)
+}
+
+// RetryValue is Retry for operations producing a value
+func RetryValue[T any](operation func() T, opts ...RetryOption) T {
+	config := retryConfig{
+		maxAttempts: 3,
+		backoff:     100 * time.Millisecond,
+		maxBackoff:  5 * time.Second,
+		retryIf:     IsRetryable,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.maxAttempts < 1 {
+		config.maxAttempts = 1
+	}
+
+	var attempts []Attempt
+	var last *Exception
+	delay := config.backoff
+	for n := 1; n <= config.maxAttempts; n++ {
+		if n > 1 && delay > 0 {
+			time.Sleep(delay)
+			delay = min(delay*2, config.maxBackoff)
+		}
+
+		var value T
+		start := time.Now()
+		ex := Try(func() {
+			value = operation()
+		}, WithName(config.name)).settle()
+		if ex == nil {
+			return value
+		}
+
+		attempts = append(attempts, Attempt{
+			Number:    n,
+			Start:     start,
+			Duration:  time.Since(start),
+			Exception: ex.Summarize(),
+		})
+		if !config.retryIf(ex) {
+			panic(*ex)
+		}
+		last = ex
+	}
+
+	ThrowWithInner(RetryExhaustedException{
+		Operation: config.name,
+		Attempts:  attempts,
+		Message:   last.Error(),
+	}, last)
+	var zero T
+	return zero
+}
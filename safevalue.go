@@ -0,0 +1,237 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ============================================================================
+// SAFE VALUES: Render arbitrary Data without cycles or unbounded output
+// ============================================================================
+
+// Limits applied when rendering values attached to exceptions
+const (
+	maxRenderDepth  = 8    // nesting levels below the value itself
+	maxRenderItems  = 64   // elements of a slice, array or map, or fields of a struct
+	maxRenderNodes  = 1024 // values visited in total
+	maxRenderString = 4096 // bytes of a string
+)
+
+// SafeValue returns value unchanged when it can be rendered (formatted, encoded to
+// JSON or gob) within bounded time and size. A value that is cyclic, nested more than
+// 8 levels deep, has collections of more than 64 elements, strings over 4 KiB or over
+// 1024 values in total is replaced by a bounded copy made of maps, slices and basic
+// values, where cycles and cut parts are marked with strings such as "[cycle]".
+//
+// RedactedData, and the reporters and serializers built on it, apply SafeValue to
+// each Data entry; custom renderers reading Data directly should do the same.
+func SafeValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	check := &safeWalk{path: make(map[safeRef]bool)}
+	if check.fits(reflect.ValueOf(value), 0) {
+		return value
+	}
+	copier := &safeWalk{path: make(map[safeRef]bool)}
+	return copier.copy(reflect.ValueOf(value), 0)
+}
+
+// formatSafe formats a value for an error message with %v, bounded like SafeValue
+func formatSafe(value interface{}) string {
+	return fmt.Sprintf("%v", SafeValue(value))
+}
+
+// safeRef identifies a reference on the current path, to detect cycles
+type safeRef struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+type safeWalk struct {
+	path  map[safeRef]bool
+	nodes int
+}
+
+// enter marks a reference as being on the current path, reporting false for a cycle
+func (w *safeWalk) enter(v reflect.Value) (safeRef, bool) {
+	ref := safeRef{ptr: v.Pointer(), typ: v.Type()}
+	if w.path[ref] {
+		return ref, false
+	}
+	w.path[ref] = true
+	return ref, true
+}
+
+// fits reports whether v is within the limits
+func (w *safeWalk) fits(v reflect.Value, depth int) bool {
+	w.nodes++
+	if w.nodes > maxRenderNodes || depth > maxRenderDepth {
+		return false
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len() <= maxRenderString
+	case reflect.Interface:
+		return v.IsNil() || w.fits(v.Elem(), depth)
+	case reflect.Pointer:
+		if v.IsNil() {
+			return true
+		}
+		ref, ok := w.enter(v)
+		if !ok {
+			return false
+		}
+		defer delete(w.path, ref)
+		return w.fits(v.Elem(), depth+1)
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return true
+		}
+		ref, ok := w.enter(v)
+		if !ok {
+			return false
+		}
+		defer delete(w.path, ref)
+		return w.fitsElements(v, depth)
+	case reflect.Array:
+		return w.fitsElements(v, depth)
+	case reflect.Struct:
+		if v.NumField() > maxRenderItems {
+			return false
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if !w.fits(v.Field(i), depth+1) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (w *safeWalk) fitsElements(v reflect.Value, depth int) bool {
+	if v.Len() > maxRenderItems {
+		return false
+	}
+	if v.Kind() == reflect.Map {
+		iter := v.MapRange()
+		for iter.Next() {
+			if !w.fits(iter.Key(), depth+1) || !w.fits(iter.Value(), depth+1) {
+				return false
+			}
+		}
+		return true
+	}
+	for i := 0; i < v.Len(); i++ {
+		if !w.fits(v.Index(i), depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// copy builds the bounded copy of v
+func (w *safeWalk) copy(v reflect.Value, depth int) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	w.nodes++
+	if w.nodes > maxRenderNodes || depth > maxRenderDepth {
+		return "[...]"
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return fmt.Sprint(v.Complex())
+	case reflect.String:
+		s := v.String()
+		if len(s) > maxRenderString {
+			return fmt.Sprintf("%s[... %d more bytes]", s[:maxRenderString], len(s)-maxRenderString)
+		}
+		return s
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return w.copy(v.Elem(), depth)
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		ref, ok := w.enter(v)
+		if !ok {
+			return "[cycle]"
+		}
+		defer delete(w.path, ref)
+		return w.copy(v.Elem(), depth+1)
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		ref, ok := w.enter(v)
+		if !ok {
+			return "[cycle]"
+		}
+		defer delete(w.path, ref)
+		if v.Kind() == reflect.Map {
+			return w.copyMap(v, depth)
+		}
+		return w.copyElements(v, depth)
+	case reflect.Array:
+		return w.copyElements(v, depth)
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField() && i < maxRenderItems; i++ {
+			fields[v.Type().Field(i).Name] = w.copy(v.Field(i), depth+1)
+		}
+		if v.NumField() > maxRenderItems {
+			fields["[...]"] = fmt.Sprintf("%d more fields", v.NumField()-maxRenderItems)
+		}
+		return fields
+	}
+	return "[" + v.Type().String() + "]"
+}
+
+func (w *safeWalk) copyElements(v reflect.Value, depth int) []interface{} {
+	n := min(v.Len(), maxRenderItems)
+	elements := make([]interface{}, 0, n+1)
+	for i := 0; i < n; i++ {
+		elements = append(elements, w.copy(v.Index(i), depth+1))
+	}
+	if v.Len() > n {
+		elements = append(elements, fmt.Sprintf("[... %d more]", v.Len()-n))
+	}
+	return elements
+}
+
+func (w *safeWalk) copyMap(v reflect.Value, depth int) map[string]interface{} {
+	n := min(v.Len(), maxRenderItems)
+	keys := make([]string, 0, n)
+	values := make(map[string]reflect.Value, n)
+	for iter := v.MapRange(); len(keys) < n && iter.Next(); {
+		keyWalk := &safeWalk{path: make(map[safeRef]bool)}
+		key := fmt.Sprint(keyWalk.copy(iter.Key(), maxRenderDepth-1))
+		keys = append(keys, key)
+		values[key] = iter.Value()
+	}
+	sort.Strings(keys)
+
+	entries := make(map[string]interface{}, n+1)
+	for _, key := range keys {
+		entries[key] = w.copy(values[key], depth+1)
+	}
+	if v.Len() > n {
+		entries["[...]"] = fmt.Sprintf("%d more entries", v.Len()-n)
+	}
+	return entries
+}
@@ -0,0 +1,98 @@
+package goexceptions
+
+import (
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ============================================================================
+// STACK SAMPLING: Full stacks for 1-in-N throws per fingerprint
+// ============================================================================
+
+var stackSampleRate atomic.Int64
+var stackSampleCounters sync.Map // fingerprint -> *atomic.Int64
+
+// packageDir is used to skip this package's own frames when locating throw sites
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// SetStackSampling captures the full stack trace for only 1 in every n throws of
+// the same fingerprint. The first occurrence of a fingerprint always gets a full
+// stack; the others only keep their Origin. Values below 2 capture every stack.
+func SetStackSampling(n int) {
+	if n < 1 {
+		n = 1
+	}
+	stackSampleRate.Store(int64(n))
+}
+
+// ResetStackSampling forgets all per-fingerprint counters
+func ResetStackSampling() {
+	stackSampleCounters.Range(func(key, _ any) bool {
+		stackSampleCounters.Delete(key)
+		return true
+	})
+}
+
+// Fingerprint identifies where and what was thrown: the exception type plus its origin
+func (e *Exception) Fingerprint() string {
+	h := fnv.New64a()
+	h.Write([]byte(e.TypeName()))
+	h.Write([]byte{'|'})
+	h.Write([]byte(e.Origin))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func shouldCaptureStack(fingerprint string) bool {
+	if stacksOff.Load() {
+		return false
+	}
+	rate := stackSampleRate.Load()
+	if rate <= 1 || samplingOff.Load() {
+		return true
+	}
+
+	counter, _ := stackSampleCounters.LoadOrStore(fingerprint, new(atomic.Int64))
+	n := counter.(*atomic.Int64).Add(1)
+	return n == 1 || n%rate == 0
+}
+
+// newException builds the exception thrown by Throw and its variants
+func newException(exception ExceptionType, inner *Exception) Exception {
+	ex := Exception{
+		Type:   bindException(exception),
+		Origin: throwOrigin(),
+		Data:   make(map[string]interface{}),
+		Inner:  inner,
+	}
+	inheritData(&ex)
+	if shouldCaptureStack(ex.Fingerprint()) {
+		ex.StackTrace = captureStackTrace(4)
+	}
+	warnDeprecated(&ex)
+	return ex
+}
+
+// throwOrigin returns the first frame outside of this package and the runtime
+func throwOrigin() string {
+	var pcs [16]uintptr
+	n := runtime.Callers(3, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		internal := filepath.Dir(frame.File) == packageDir && !strings.HasSuffix(frame.File, "_test.go")
+		if !internal && !strings.HasPrefix(frame.Function, "runtime.") {
+			return fmt.Sprintf("%s:%d %s", frame.File, frame.Line, frame.Function)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
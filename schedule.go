@@ -0,0 +1,197 @@
+package goexceptions
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// SCHEDULED JOBS: Periodic tasks with exception-aware skip/disable
+// ============================================================================
+
+// ScheduledJobDisabledException is reported to observers when a job is disabled
+// after too many consecutive failures
+type ScheduledJobDisabledException struct {
+	Job      string
+	Failures int
+	Message  string
+}
+
+func (e ScheduledJobDisabledException) Error() string {
+	return fmt.Sprintf("ScheduledJobDisabledException: job '%s' disabled after %d consecutive failures. %s", e.Job, e.Failures, e.Message)
+}
+
+func (e ScheduledJobDisabledException) TypeName() string {
+	return "ScheduledJobDisabledException"
+}
+
+// ScheduleOption configures a scheduled job
+type ScheduleOption func(*scheduleConfig)
+
+type scheduleConfig struct {
+	name         string
+	skipAfter    int
+	disableAfter int
+}
+
+// WithJobName names the job; runs are Try blocks named after it
+func WithJobName(name string) ScheduleOption {
+	return func(c *scheduleConfig) {
+		c.name = name
+	}
+}
+
+// WithSkipAfter skips one run after every n consecutive failures, as a simple backoff
+func WithSkipAfter(n int) ScheduleOption {
+	return func(c *scheduleConfig) {
+		c.skipAfter = n
+	}
+}
+
+// WithDisableAfter disables the job after n consecutive failures
+func WithDisableAfter(n int) ScheduleOption {
+	return func(c *scheduleConfig) {
+		c.disableAfter = n
+	}
+}
+
+// ScheduledJob is a periodic task started by Schedule
+type ScheduledJob struct {
+	config scheduleConfig
+	job    func()
+
+	mu          sync.Mutex
+	runs        int
+	skips       int
+	consecutive int
+	skippedLast bool
+	disabled    bool
+	lastReport  TryReport
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// Schedule runs job every interval inside a Try until Stop is called. Failed runs are
+// reported to observers as unhandled exceptions; repeated failures can skip runs
+// (WithSkipAfter) or disable the job (WithDisableAfter), which reports a
+// ScheduledJobDisabledException. Strict mode does not apply, as with Go.
+func Schedule(interval time.Duration, job func(), opts ...ScheduleOption) *ScheduledJob {
+	sj := &ScheduledJob{
+		config: scheduleConfig{name: "scheduled-job"},
+		job:    job,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(&sj.config)
+	}
+
+	track(liveJobs, sj, true)
+	go sj.loop(interval)
+	return sj
+}
+
+func (sj *ScheduledJob) loop(interval time.Duration) {
+	defer close(sj.done)
+	defer track(liveJobs, sj, false)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sj.stop:
+			return
+		case <-ticker.C:
+			if !sj.tick() {
+				return
+			}
+		}
+	}
+}
+
+// tick runs or skips one occurrence; it returns false once the job is disabled
+func (sj *ScheduledJob) tick() bool {
+	sj.mu.Lock()
+	if sj.config.skipAfter > 0 && sj.consecutive > 0 && sj.consecutive%sj.config.skipAfter == 0 && !sj.skippedLast {
+		sj.skippedLast = true
+		sj.skips++
+		sj.mu.Unlock()
+		return true
+	}
+	sj.skippedLast = false
+	sj.mu.Unlock()
+
+	tr := Try(sj.job, WithName(sj.config.name))
+	tr.complete()
+
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+
+	sj.runs++
+	sj.lastReport = tr.Report()
+	if !tr.HasException() {
+		sj.consecutive = 0
+		return true
+	}
+
+	sj.consecutive++
+	if sj.config.disableAfter > 0 && sj.consecutive >= sj.config.disableAfter {
+		sj.disabled = true
+		notifyException(EventUnhandled, &Exception{
+			Type: ScheduledJobDisabledException{
+				Job:      sj.config.name,
+				Failures: sj.consecutive,
+				Message:  tr.GetException().Error(),
+			},
+			Data:  make(map[string]interface{}),
+			Inner: tr.GetException(),
+		}, sj.config.name)
+		return false
+	}
+	return true
+}
+
+// Stop stops the job and waits for a running occurrence to finish
+func (sj *ScheduledJob) Stop() {
+	sj.stopOnce.Do(func() { close(sj.stop) })
+	<-sj.done
+}
+
+// Disabled reports whether the job was disabled after consecutive failures
+func (sj *ScheduledJob) Disabled() bool {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+	return sj.disabled
+}
+
+// Runs returns how many times the job ran
+func (sj *ScheduledJob) Runs() int {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+	return sj.runs
+}
+
+// Skips returns how many runs were skipped after failures
+func (sj *ScheduledJob) Skips() int {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+	return sj.skips
+}
+
+// ConsecutiveFailures returns the current streak of failed runs
+func (sj *ScheduledJob) ConsecutiveFailures() int {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+	return sj.consecutive
+}
+
+// LastReport returns the report of the most recent run
+func (sj *ScheduledJob) LastReport() TryReport {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+	return sj.lastReport
+}
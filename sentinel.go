@@ -0,0 +1,136 @@
+package goexceptions
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ============================================================================
+// SENTINEL HANDLERS: Match exceptions by the error values that caused them
+// ============================================================================
+
+// SentinelHandler catches exceptions whose cause chain matches a sentinel error
+type SentinelHandler struct {
+	sentinel error
+	handler  func(Exception)
+}
+
+func (sh *SentinelHandler) Handle(ex Exception) bool {
+	if !sh.Matches(ex) {
+		return false
+	}
+	sh.handler(ex)
+	return true
+}
+
+// Matches reports whether the handler catches ex, without running it
+func (sh *SentinelHandler) Matches(ex Exception) bool {
+	return causedBy(&ex, sh.sentinel)
+}
+
+// HandlerName describes the handler in reports
+func (sh *SentinelHandler) HandlerName() string {
+	return "HandlerSentinel[" + sh.sentinel.Error() + "]"
+}
+
+// HandlerSentinel creates a handler for exceptions caused by err, such as io.EOF or
+// sql.ErrNoRows: the exception or one of its inner exceptions was translated from an
+// error, or carries a cause, for which errors.Is(cause, err) holds
+func HandlerSentinel(err error, handler func(Exception)) ExceptionHandler {
+	return &SentinelHandler{sentinel: err, handler: handler}
+}
+
+// causedBy walks the exception and its inner exceptions looking for target
+func causedBy(ex *Exception, target error) bool {
+	for current := ex; current != nil; current = current.Inner {
+		if current.cause != nil && errors.Is(current.cause, target) {
+			return true
+		}
+		if cause := exceptionCause(current.Type); cause != nil && errors.Is(cause, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// exceptionCause returns the error wrapped by an exception type: the Cause of the
+// built-in types, or the result of Unwrap for custom types implementing it
+func exceptionCause(exceptionType ExceptionType) error {
+	switch e := exceptionType.(type) {
+	case FileException:
+		return e.Cause
+	case NetworkException:
+		return e.Cause
+	case IOException:
+		return e.Cause
+	case ParseException:
+		return e.Cause
+	case TemplateException:
+		return e.Cause
+	case interface{ Unwrap() error }:
+		return e.Unwrap()
+	case interface{ Unwrap() []error }:
+		return errors.Join(e.Unwrap()...)
+	}
+	return nil
+}
+
+// Unwrap exposes the error the exception was translated from, or the Cause of its
+// type, and its inner exception, so errors.Is and errors.As see through exceptions
+// that leave the package as plain errors. When there are both, they are returned
+// joined with errors.Join.
+func (e Exception) Unwrap() error {
+	cause := exceptionCause(e.Type)
+	if cause == nil {
+		cause = e.cause
+	}
+	switch {
+	case e.Inner == nil:
+		return cause
+	case cause == nil:
+		return e.Inner
+	}
+	return errors.Join(cause, e.Inner)
+}
+
+// As lets errors.As extract the exception type, such as a TimeoutException, a parent
+// it embeds (see BaseException) or an interface it implements, from an error holding
+// the exception. Pointer targets work too, receiving a pointer to a copy:
+//
+//	var timeout *TimeoutException
+//	if errors.As(err, &timeout) { ... }
+func (e Exception) As(target any) bool {
+	if e.Type == nil {
+		return false
+	}
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return false
+	}
+	want := value.Type().Elem()
+	if typed, ok := exceptionValueAs(e.Type, want); ok {
+		value.Elem().Set(typed)
+		return true
+	}
+	if want.Kind() == reflect.Pointer && want.Elem().Kind() == reflect.Struct {
+		if typed, ok := exceptionValueAs(e.Type, want.Elem()); ok {
+			copied := reflect.New(want.Elem())
+			copied.Elem().Set(typed)
+			value.Elem().Set(copied)
+			return true
+		}
+	}
+	return false
+}
+
+// exceptionValueAs is exceptionAs for a type known at run time
+func exceptionValueAs(exceptionType ExceptionType, want reflect.Type) (reflect.Value, bool) {
+	actual := reflect.TypeOf(exceptionType)
+	if actual.AssignableTo(want) {
+		return reflect.ValueOf(exceptionType), true
+	}
+	if path := embedPath(actual, want); path != nil {
+		return reflect.ValueOf(exceptionType).FieldByIndex(path), true
+	}
+	return reflect.Value{}, false
+}
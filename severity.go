@@ -0,0 +1,162 @@
+package goexceptions
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"sync"
+)
+
+// ============================================================================
+// SEVERITY SCORING: One number for alert routers to triage on
+// ============================================================================
+
+// Blame tells whose failure an exception is
+type Blame int
+
+const (
+	BlameService    Blame = iota // a failure of this service
+	BlameCaller                  // invalid, unauthenticated or conflicting requests
+	BlameDependency              // a failing, slow or unavailable dependency
+)
+
+func (b Blame) String() string {
+	switch b {
+	case BlameCaller:
+		return "caller"
+	case BlameDependency:
+		return "dependency"
+	default:
+		return "service"
+	}
+}
+
+// BlameFor derives the blame of an exception from its HTTP status (see HTTPStatusFor)
+// and policy: 4xx statuses blame the caller, retryable exceptions and 502, 503 and
+// 504 blame a dependency, and anything else blames the service
+func BlameFor(ex *Exception) Blame {
+	status := HTTPStatusFor(ex)
+	switch {
+	case status >= 400 && status < 500:
+		return BlameCaller
+	case IsRetryable(ex), status == http.StatusBadGateway, status == http.StatusServiceUnavailable, status == http.StatusGatewayTimeout:
+		return BlameDependency
+	default:
+		return BlameService
+	}
+}
+
+// ScoreInput is what a Scorer weighs, gathered from the package's registries
+type ScoreInput struct {
+	Exception   *Exception
+	Handled     bool
+	Severity    slog.Level  // see LogLevelFor
+	SLO         SLOCategory // the type class, from the policy in effect
+	Occurrences int64       // times its fingerprint was caught so far (see Stats)
+	Blame       Blame
+}
+
+// Scorer turns an exception into a severity score, higher meaning more urgent
+type Scorer interface {
+	Score(input ScoreInput) float64
+}
+
+// ScorerFunc adapts a function to the Scorer interface
+type ScorerFunc func(input ScoreInput) float64
+
+func (f ScorerFunc) Score(input ScoreInput) float64 {
+	return f(input)
+}
+
+// ScoreWeights weigh the factors of WeightedScorer, each rated from 0 to 1
+type ScoreWeights struct {
+	Severity   float64 // Debug 0 up to Error 1
+	SLO        float64 // none 0.25, latency 0.5, availability and correctness 1
+	Frequency  float64 // logarithmic, 1 from a thousand occurrences
+	Blame      float64 // caller 0.2, dependency 0.6, service 1
+	Unhandled  float64 // 1 when no handler consumed the exception
+	Multiplier float64 // scales the weighted sum, 100 by default
+}
+
+// DefaultScoreWeights are used until SetScorer installs another scorer
+var DefaultScoreWeights = ScoreWeights{Severity: 0.3, SLO: 0.2, Frequency: 0.2, Blame: 0.15, Unhandled: 0.15, Multiplier: 100}
+
+// WeightedScorer returns a scorer summing the factors of an exception by weights.
+// With weights adding up to 1, scores range from 0 to the multiplier.
+func WeightedScorer(weights ScoreWeights) Scorer {
+	if weights.Multiplier == 0 {
+		weights.Multiplier = 100
+	}
+	return ScorerFunc(func(input ScoreInput) float64 {
+		severity := math.Min(math.Max(float64(input.Severity-slog.LevelDebug)/float64(slog.LevelError-slog.LevelDebug), 0), 1)
+
+		slo := 0.25
+		switch input.SLO {
+		case SLOLatency:
+			slo = 0.5
+		case SLOAvailability, SLOCorrectness:
+			slo = 1
+		}
+
+		frequency := math.Min(math.Log10(1+float64(input.Occurrences))/3, 1)
+
+		blame := 1.0
+		switch input.Blame {
+		case BlameCaller:
+			blame = 0.2
+		case BlameDependency:
+			blame = 0.6
+		}
+
+		unhandled := 0.0
+		if !input.Handled {
+			unhandled = 1
+		}
+
+		return weights.Multiplier * (weights.Severity*severity + weights.SLO*slo + weights.Frequency*frequency +
+			weights.Blame*blame + weights.Unhandled*unhandled)
+	})
+}
+
+var scorerMutex sync.RWMutex
+var scorer Scorer
+
+// SetScorer installs the scorer of ScoreFor and reports. Pass nil to restore
+// WeightedScorer with DefaultScoreWeights.
+func SetScorer(s Scorer) {
+	scorerMutex.Lock()
+	defer scorerMutex.Unlock()
+	scorer = s
+}
+
+// ScoreFor scores an exception with the installed scorer, for alert routers to
+// triage on structured data:
+//
+//	if ScoreFor(event.Exception, event.Kind == EventHandled) >= 70 {
+//	    page(event)
+//	}
+func ScoreFor(ex *Exception, handled bool) float64 {
+	if ex == nil {
+		return 0
+	}
+	return score(ex, handled, PolicyFor(ex))
+}
+
+// score scores ex under policy, which a Try may override with WithPolicy
+func score(ex *Exception, handled bool, policy ExceptionPolicy) float64 {
+	scorerMutex.RLock()
+	current := scorer
+	scorerMutex.RUnlock()
+	if current == nil {
+		current = WeightedScorer(DefaultScoreWeights)
+	}
+
+	return current.Score(ScoreInput{
+		Exception:   ex,
+		Handled:     handled,
+		Severity:    LogLevelFor(ex, handled),
+		SLO:         policy.SLO,
+		Occurrences: fingerprintCount(ex.Fingerprint()),
+		Blame:       BlameFor(ex),
+	})
+}
@@ -0,0 +1,115 @@
+package goexceptions
+
+import "sync/atomic"
+
+// ============================================================================
+// SHADOW MODE: Compare a candidate handler set with the current one
+// ============================================================================
+
+// HandlerProbe is implemented by handlers that can tell whether they would catch an
+// exception without running. The built-in handlers implement it; shadow comparisons
+// rely on it.
+type HandlerProbe interface {
+	Matches(ex Exception) bool
+}
+
+// ShadowComparison records which handler each set would pick for an exception.
+// Handlers are identified by the names used in reports (see TryReport.Handler).
+type ShadowComparison struct {
+	Name      string // operation name given with WithName
+	Exception *Exception
+	Current   string // handler picked by the current set, "" if none matched
+	Candidate string // handler picked by the candidate set, "" if none matched
+	Undecided bool   // a handler that is not a HandlerProbe was reached before a match
+}
+
+// When Undecided, Current or Candidate names the handler that could not be probed.
+
+// Diverged reports whether the two sets pick different handlers
+func (c ShadowComparison) Diverged() bool {
+	return !c.Undecided && c.Current != c.Candidate
+}
+
+// ShadowStats counts the comparisons made by a Shadow
+type ShadowStats struct {
+	Compared  int64
+	Diverged  int64
+	Undecided int64
+}
+
+// Shadow runs exceptions past a candidate handler set without executing it, to
+// migrate safely when restructuring handler hierarchies or switching to interface
+// based matching. Use it with HandleShadowed in place of Handle.
+type Shadow struct {
+	candidate    []ExceptionHandler
+	onDivergence func(ShadowComparison)
+	compared     atomic.Int64
+	diverged     atomic.Int64
+	undecided    atomic.Int64
+}
+
+// NewShadow creates a shadow for the candidate handlers. onDivergence, which may be
+// nil, is called for each exception the sets would handle differently, and for each
+// comparison that could not be decided. Its panics are ignored.
+func NewShadow(onDivergence func(ShadowComparison), candidate ...ExceptionHandler) *Shadow {
+	return &Shadow{candidate: candidate, onDivergence: onDivergence}
+}
+
+// Stats returns the comparisons made so far
+func (s *Shadow) Stats() ShadowStats {
+	return ShadowStats{
+		Compared:  s.compared.Load(),
+		Diverged:  s.diverged.Load(),
+		Undecided: s.undecided.Load(),
+	}
+}
+
+// HandleShadowed is Handle with the handlers as the current set, first compared with
+// the candidate set of shadow. Only the current handlers run.
+func (tr *TryResult) HandleShadowed(shadow *Shadow, handlers ...ExceptionHandler) *TryResult {
+	tr.passThrough()
+	if tr == nil || tr.exception == nil || tr.handled {
+		return tr
+	}
+
+	comparison := ShadowComparison{Name: tr.config.name, Exception: tr.exception}
+	var currentDecided, candidateDecided bool
+	comparison.Current, currentDecided = probeHandlers(*tr.exception, handlers)
+	comparison.Candidate, candidateDecided = probeHandlers(*tr.exception, shadow.candidate)
+	comparison.Undecided = !currentDecided || !candidateDecided
+
+	shadow.compared.Add(1)
+	switch {
+	case comparison.Undecided:
+		shadow.undecided.Add(1)
+	case comparison.Diverged():
+		shadow.diverged.Add(1)
+	}
+	if (comparison.Undecided || comparison.Diverged()) && shadow.onDivergence != nil {
+		func() {
+			defer func() { recover() }()
+			shadow.onDivergence(comparison)
+		}()
+	}
+
+	return tr.Handle(handlers...)
+}
+
+// probeHandlers returns the name of the first handler matching ex, and false if a
+// handler that cannot be probed comes first
+func probeHandlers(ex Exception, handlers []ExceptionHandler) (string, bool) {
+	for _, handler := range handlers {
+		probed := handler
+		if timed, ok := handler.(*timedHandler); ok {
+			probed = timed.handler
+		}
+		probe, ok := probed.(HandlerProbe)
+		if !ok {
+			return handlerRef{handler: handler}.String(), false
+		}
+		if probe.Matches(ex) {
+			return handlerRef{handler: handler}.String(), true
+		}
+	}
+	return "", true
+}
@@ -0,0 +1,112 @@
+package goexceptions
+
+import (
+	"context"
+	"sync"
+)
+
+// ============================================================================
+// SHUTDOWN: Flush asynchronous reporting before the process exits
+// ============================================================================
+
+// inflight counts background work and lets callers wait for it to finish
+type inflight struct {
+	mu   sync.Mutex
+	n    int
+	idle chan struct{} // closed when n drops to zero
+}
+
+func (f *inflight) add() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.n == 0 {
+		f.idle = make(chan struct{})
+	}
+	f.n++
+}
+
+func (f *inflight) done() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.n--
+	if f.n == 0 {
+		close(f.idle)
+	}
+}
+
+func (f *inflight) wait(ctx context.Context) error {
+	f.mu.Lock()
+	if f.n == 0 {
+		f.mu.Unlock()
+		return nil
+	}
+	idle := f.idle
+	f.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// abandoned tracks blocks, cleanups and handlers left running by TryWithTimeout,
+// FinallyWithin and handler timeouts, whose late failures are still to be reported
+var abandoned inflight
+
+var liveMutex sync.Mutex
+var liveJobs = make(map[*ScheduledJob]struct{})
+var liveAsyncObservers = make(map[*AsyncObserver]struct{})
+
+func track[T comparable](set map[T]struct{}, item T, live bool) {
+	liveMutex.Lock()
+	defer liveMutex.Unlock()
+	if live {
+		set[item] = struct{}{}
+	} else {
+		delete(set, item)
+	}
+}
+
+func snapshotLive[T comparable](set map[T]struct{}) []T {
+	liveMutex.Lock()
+	defer liveMutex.Unlock()
+	items := make([]T, 0, len(set))
+	for item := range set {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Shutdown prepares the package for process exit, so exceptions raised in its last
+// seconds are not lost. In order, it:
+//
+//   - stops scheduled jobs, waiting for running occurrences to finish;
+//   - waits for blocks abandoned by TryWithTimeout, cleanups abandoned by
+//     FinallyWithin and handlers abandoned by handler timeouts, so their late
+//     failures are reported;
+//   - closes every AsyncObserver, flushing its queue.
+//
+// It returns ctx's error if ctx is done before everything is flushed.
+func Shutdown(ctx context.Context) error {
+	for _, job := range snapshotLive(liveJobs) {
+		job.stopOnce.Do(func() { close(job.stop) })
+		select {
+		case <-job.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := abandoned.wait(ctx); err != nil {
+		return err
+	}
+
+	for _, async := range snapshotLive(liveAsyncObservers) {
+		if err := async.Close(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
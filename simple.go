@@ -0,0 +1,136 @@
+package goexceptions
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// SIMPLE EXCEPTIONS: Embeddable struct with generated Error/TypeName
+// ============================================================================
+
+// SimpleException covers the common case of a custom exception: embed it and
+// declare extra fields, without writing Error or TypeName by hand.
+//
+//	type PaymentDeclinedException struct {
+//	    SimpleException
+//	    OrderID string
+//	}
+//
+//	Throw(PaymentDeclinedException{
+//	    SimpleException: SimpleException{Message: "card declined", Code: "card_declined"},
+//	    OrderID:         "A-17",
+//	})
+//	// PaymentDeclinedException: card declined (Code: card_declined, OrderID: A-17)
+//
+// Throw binds the embedding type's name and fields to the value, so Error and TypeName
+// describe the outer type. The field layout is found by reflection once per type.
+type SimpleException struct {
+	Message string
+	Code    string
+	Cause   error
+
+	typeName string
+	fields   string
+}
+
+func (e SimpleException) Error() string {
+	var details []string
+	if e.Code != "" {
+		details = append(details, "Code: "+e.Code)
+	}
+	if e.fields != "" {
+		details = append(details, e.fields)
+	}
+	if e.Cause != nil {
+		details = append(details, fmt.Sprintf("Cause: %v", e.Cause))
+	}
+
+	if len(details) == 0 {
+		return fmt.Sprintf("%s: %s", e.TypeName(), e.Message)
+	}
+	return fmt.Sprintf("%s: %s (%s)", e.TypeName(), e.Message, strings.Join(details, ", "))
+}
+
+// Unwrap returns the Cause, so errors.Is and HandlerSentinel see through the
+// exception to it
+func (e SimpleException) Unwrap() error {
+	return e.Cause
+}
+
+func (e SimpleException) TypeName() string {
+	if e.typeName != "" {
+		return e.typeName
+	}
+	return "SimpleException"
+}
+
+func (e *SimpleException) bindSimple(typeName, fields string) {
+	e.typeName = typeName
+	e.fields = fields
+}
+
+// simpleLayout caches, per type embedding SimpleException, the fields to render
+type simpleLayout struct {
+	typeName string
+	fields   []int
+}
+
+var simpleLayouts sync.Map // reflect.Type -> *simpleLayout (nil when not embedding)
+
+var simpleExceptionType = reflect.TypeOf(SimpleException{})
+
+func simpleLayoutFor(t reflect.Type) *simpleLayout {
+	if cached, ok := simpleLayouts.Load(t); ok {
+		return cached.(*simpleLayout)
+	}
+
+	var layout *simpleLayout
+	if t.Kind() == reflect.Struct {
+		var fields []int
+		embeds := false
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			switch {
+			case field.Anonymous && field.Type == simpleExceptionType:
+				embeds = true
+			case field.IsExported() && !field.Anonymous:
+				fields = append(fields, i)
+			}
+		}
+		if embeds {
+			layout = &simpleLayout{typeName: typeNameOf(t), fields: fields}
+		}
+	}
+
+	simpleLayouts.Store(t, layout)
+	return layout
+}
+
+// bindException binds an embedded SimpleException or BaseException to the outer type
+func bindException(ex ExceptionType) ExceptionType {
+	return bindBaseException(bindSimpleException(ex))
+}
+
+// bindSimpleException returns a copy of ex with its embedded SimpleException bound
+// to the outer type, or ex itself for any other type
+func bindSimpleException(ex ExceptionType) ExceptionType {
+	t := reflect.TypeOf(ex)
+	layout := simpleLayoutFor(t)
+	if layout == nil {
+		return ex
+	}
+
+	value := reflect.New(t).Elem()
+	value.Set(reflect.ValueOf(ex))
+
+	rendered := make([]string, 0, len(layout.fields))
+	for _, i := range layout.fields {
+		rendered = append(rendered, t.Field(i).Name+": "+formatSafe(value.Field(i).Interface()))
+	}
+
+	value.Addr().Interface().(interface{ bindSimple(string, string) }).bindSimple(layout.typeName, strings.Join(rendered, ", "))
+	return value.Interface().(ExceptionType)
+}
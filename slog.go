@@ -0,0 +1,409 @@
+package goexceptions
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// ============================================================================
+// SLOG INTEGRATION: Structured logging of exception events
+// ============================================================================
+
+// LogLevels decides the log level of an exception type depending on whether it
+// was handled
+type LogLevels struct {
+	Handled   slog.Level
+	Unhandled slog.Level
+}
+
+// fallbackLogLevels apply to types without registered or built-in levels
+var fallbackLogLevels = LogLevels{Handled: slog.LevelInfo, Unhandled: slog.LevelError}
+
+var logLevelsMutex sync.RWMutex
+var logLevels = make(map[reflect.Type]LogLevels)
+
+// defaultLogLevels keep expected validation failures out of error-level logs
+var defaultLogLevels = map[reflect.Type]LogLevels{
+	reflect.TypeOf(ArgumentNullException{}):       {Handled: slog.LevelDebug, Unhandled: slog.LevelError},
+	reflect.TypeOf(ArgumentOutOfRangeException{}): {Handled: slog.LevelDebug, Unhandled: slog.LevelError},
+}
+
+// RegisterLogLevels sets the log levels for exceptions of type T
+func RegisterLogLevels[T ExceptionType](levels LogLevels) {
+	checkTypeNameOf[T]()
+	logLevelsMutex.Lock()
+	defer logLevelsMutex.Unlock()
+	logLevels[getTypeOf[T]()] = levels
+}
+
+// UnregisterLogLevels removes the log levels for exceptions of type T, restoring its default
+func UnregisterLogLevels[T ExceptionType]() {
+	logLevelsMutex.Lock()
+	defer logLevelsMutex.Unlock()
+	delete(logLevels, getTypeOf[T]())
+}
+
+// LogLevelFor returns the level at which the exception is logged when handled or
+// unhandled: the registered levels for its type, its built-in default,
+// or Info when handled and Error when unhandled. Known issues (see
+// RegisterKnownIssue) are logged at KnownIssueLogLevel at most.
+func LogLevelFor(ex *Exception, handled bool) slog.Level {
+	levels := fallbackLogLevels
+	if ex != nil && ex.Type != nil {
+		exceptionType := reflect.TypeOf(ex.Type)
+
+		logLevelsMutex.RLock()
+		if registered, exists := logLevels[exceptionType]; exists {
+			levels = registered
+		} else if builtin, exists := defaultLogLevels[exceptionType]; exists {
+			levels = builtin
+		}
+		logLevelsMutex.RUnlock()
+	}
+
+	level := levels.Unhandled
+	if handled {
+		level = levels.Handled
+	}
+	if _, known := KnownIssueFor(ex); known {
+		level = min(level, KnownIssueLogLevel)
+	}
+	return level
+}
+
+// SlogObserver returns an observer that logs handled and unhandled exceptions at the
+// level chosen by LogLevelFor, warnings sent with Report at warn level, and handler
+// failures at error level
+func SlogObserver(logger *slog.Logger) Observer {
+	return ObserverFunc(func(event Event) {
+		var level slog.Level
+		var msg string
+		switch event.Kind {
+		case EventHandled:
+			level, msg = LogLevelFor(event.Exception, true), "exception handled"
+		case EventUnhandled:
+			level, msg = LogLevelFor(event.Exception, false), "exception unhandled"
+		case EventWarning:
+			level, msg = slog.LevelWarn, "exception reported"
+		case EventHandlerFailed:
+			level, msg = slog.LevelError, "exception handler failed"
+		default:
+			return
+		}
+
+		ctx := context.Background()
+		if !logger.Enabled(ctx, level) {
+			return
+		}
+		logger.LogAttrs(ctx, level, msg, eventAttrs(event)...)
+	})
+}
+
+func eventAttrs(event Event) []slog.Attr {
+	attrs := make([]slog.Attr, 0, 6)
+	if ex := event.Exception; ex != nil {
+		attrs = append(attrs,
+			slog.String("exception.type", ex.TypeName()),
+			slog.String("exception.message", ex.Error()),
+			slog.String("exception.fingerprint", ex.Fingerprint()),
+		)
+		if ex.Origin != "" {
+			attrs = append(attrs, slog.String("exception.origin", ex.Origin))
+		}
+		if issue, known := KnownIssueFor(ex); known {
+			attrs = append(attrs, slog.String("exception.known_issue", issue.Ticket))
+		}
+	}
+	if event.Name != "" {
+		attrs = append(attrs, slog.String("operation", event.Name))
+	}
+	if event.Tenant != "" {
+		attrs = append(attrs, slog.String("tenant", event.Tenant))
+	}
+	return attrs
+}
+
+// ExceptionLogOptions configures NewExceptionLogHandler
+type ExceptionLogOptions struct {
+	// Group holds the exception fields. When empty, the key of the attribute carrying
+	// the exception is used, so logger.Error("failed", "err", ex) yields err.type,
+	// err.fingerprint and so on.
+	Group string
+	// Flat writes the fields as dotted keys, such as "exception.type" as logged by
+	// SlogObserver, instead of a nested group
+	Flat bool
+}
+
+// exceptionLogHandler expands exception attributes before delegating to next
+type exceptionLogHandler struct {
+	next    slog.Handler
+	options ExceptionLogOptions
+}
+
+// NewExceptionLogHandler wraps next so that any attribute holding an Exception, an
+// *Exception or an error wrapping one (such as an ExceptionError) is expanded into
+// type, message, fingerprint, code, origin and tenant fields, whoever writes the log
+// call:
+//
+//	logger := slog.New(NewExceptionLogHandler(slog.NewJSONHandler(os.Stderr, nil), ExceptionLogOptions{}))
+//	logger.Error("checkout failed", "err", full)
+//
+// The tenant is the one of the Try that caught the exception, else the TenantKey
+// entry of its Data, else the one extracted from the context of the log call.
+// Records carrying a known issue (see RegisterKnownIssue) are lowered to
+// KnownIssueLogLevel.
+func NewExceptionLogHandler(next slog.Handler, options ExceptionLogOptions) slog.Handler {
+	return &exceptionLogHandler{next: next, options: options}
+}
+
+func (h *exceptionLogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *exceptionLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	var attrs []slog.Attr
+	var known bool
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = append(attrs, h.expand(ctx, attr, &known)...)
+		return true
+	})
+
+	level := record.Level
+	if known {
+		level = min(level, KnownIssueLogLevel)
+	}
+	expanded := slog.NewRecord(record.Time, level, record.Message, record.PC)
+	expanded.AddAttrs(attrs...)
+	return h.next.Handle(ctx, expanded)
+}
+
+func (h *exceptionLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	expanded := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		expanded = append(expanded, h.expand(context.Background(), attr, new(bool))...)
+	}
+	return &exceptionLogHandler{next: h.next.WithAttrs(expanded), options: h.options}
+}
+
+func (h *exceptionLogHandler) WithGroup(name string) slog.Handler {
+	return &exceptionLogHandler{next: h.next.WithGroup(name), options: h.options}
+}
+
+// expand replaces an attribute carrying an exception with its fields, looking into
+// groups, and sets known when one of them is a known issue
+func (h *exceptionLogHandler) expand(ctx context.Context, attr slog.Attr, known *bool) []slog.Attr {
+	// Exceptions are LogValuers themselves, so look for them before resolving
+	ex := exceptionIn(attr.Value)
+	if ex == nil {
+		value := attr.Value.Resolve()
+		if value.Kind() == slog.KindGroup {
+			group := value.Group()
+			expanded := make([]slog.Attr, 0, len(group))
+			for _, member := range group {
+				expanded = append(expanded, h.expand(ctx, member, known)...)
+			}
+			return []slog.Attr{{Key: attr.Key, Value: slog.GroupValue(expanded...)}}
+		}
+		ex = exceptionIn(value)
+	}
+	if ex == nil {
+		return []slog.Attr{attr}
+	}
+	if _, isKnown := KnownIssueFor(ex); isKnown {
+		*known = true
+	}
+
+	group := h.options.Group
+	if group == "" {
+		group = attr.Key
+	}
+	fields := exceptionLogFields(ctx, ex)
+	if !h.options.Flat {
+		return []slog.Attr{{Key: group, Value: slog.GroupValue(fields...)}}
+	}
+	for i := range fields {
+		fields[i].Key = group + "." + fields[i].Key
+	}
+	return fields
+}
+
+// exceptionIn returns the exception held by a log value, or nil
+func exceptionIn(value slog.Value) *Exception {
+	if kind := value.Kind(); kind != slog.KindAny && kind != slog.KindLogValuer {
+		return nil
+	}
+	switch v := value.Any().(type) {
+	case Exception:
+		return &v
+	case *Exception:
+		return v
+	case error:
+		var ex Exception
+		if errors.As(v, &ex) {
+			return &ex
+		}
+	}
+	return nil
+}
+
+func exceptionLogFields(ctx context.Context, ex *Exception) []slog.Attr {
+	fields := make([]slog.Attr, 0, 6)
+	fields = append(fields,
+		slog.String("type", ex.TypeName()),
+		slog.String("message", ex.Error()),
+		slog.String("fingerprint", ex.Fingerprint()),
+	)
+	if code := codeOf(ex.Type); code != "" {
+		fields = append(fields, slog.String("code", code))
+	}
+	if ex.Origin != "" {
+		fields = append(fields, slog.String("origin", ex.Origin))
+	}
+	if tenant := exceptionTenant(ctx, ex); tenant != "" {
+		fields = append(fields, slog.String("tenant", tenant))
+	}
+	if issue, known := KnownIssueFor(ex); known {
+		fields = append(fields, slog.String("known_issue", issue.Ticket))
+	}
+	return fields
+}
+
+func exceptionTenant(ctx context.Context, ex *Exception) string {
+	if tenant := ex.owner.Tenant(); tenant != "" {
+		return tenant
+	}
+	if tenant, ok := GetTyped(ex, TenantKey); ok && tenant != "" {
+		return tenant
+	}
+	return newTryResult([]TryOption{WithContext(ctx)}).Tenant()
+}
+
+// CatchLogged is Catch with a handler that logs the exception with the fields of
+// SlogObserver, at the level chosen by LogLevelFor, and swallows it:
+//
+//	CatchLogged[NetworkException](Try(sync), logger, "sync skipped").End()
+func CatchLogged[T ExceptionType](tr *TryResult, logger *slog.Logger, msg string) *TryResult {
+	return Catch(tr, func(_ T, full Exception) {
+		logException(logger, LogLevelFor(&full, true), msg, full)
+	})
+}
+
+// loggedHandler logs the exceptions of type T it catches
+type loggedHandler[T ExceptionType] struct {
+	logger *slog.Logger
+	level  slog.Level
+}
+
+// HandlerLogged returns a handler that catches exceptions of type T and logs them
+// with the fields of SlogObserver at level, or at KnownIssueLogLevel for known issues
+func HandlerLogged[T ExceptionType](logger *slog.Logger, level slog.Level) ExceptionHandler {
+	return &loggedHandler[T]{logger: logger, level: level}
+}
+
+func (lh *loggedHandler[T]) Handle(ex Exception) bool {
+	if !lh.Matches(ex) {
+		return false
+	}
+	level := lh.level
+	if _, known := KnownIssueFor(&ex); known {
+		level = min(level, KnownIssueLogLevel)
+	}
+	logException(lh.logger, level, "exception handled", ex)
+	return true
+}
+
+// Matches reports whether the handler catches ex, without running it
+func (lh *loggedHandler[T]) Matches(ex Exception) bool {
+	return isTypeMatch[T](reflect.TypeOf(ex.Type))
+}
+
+// HandlerName describes the handler in reports
+func (lh *loggedHandler[T]) HandlerName() string {
+	return "HandlerLogged[" + getTypeOf[T]().Name() + "]"
+}
+
+func logException(logger *slog.Logger, level slog.Level, msg string, ex Exception) {
+	ctx := ex.Context()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+	event := Event{Kind: EventHandled, Exception: &ex, Tenant: ex.owner.Tenant()}
+	if ex.owner != nil {
+		event.Name = ex.owner.config.name
+	}
+	logger.LogAttrs(ctx, level, msg, eventAttrs(event)...)
+}
+
+// LogValue implements slog.LogValuer, so an exception logged as an attribute becomes
+// a group of type, message, fingerprint, code, origin, data (redacted, see
+// RedactedData), stack and inner, the inner exception in the same shape down to 8
+// levels:
+//
+//	logger.Error("checkout failed", "err", full)
+func (e Exception) LogValue() slog.Value {
+	return e.logValue(0)
+}
+
+func (e Exception) logValue(depth int) slog.Value {
+	attrs := make([]slog.Attr, 0, 8)
+	attrs = append(attrs,
+		slog.String("type", e.TypeName()),
+		slog.String("message", e.Error()),
+		slog.String("fingerprint", e.Fingerprint()),
+	)
+	if code := codeOf(e.Type); code != "" {
+		attrs = append(attrs, slog.String("code", code))
+	}
+	if e.Origin != "" {
+		attrs = append(attrs, slog.String("origin", e.Origin))
+	}
+	if data := e.RedactedData(); len(data) > 0 {
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fields := make([]slog.Attr, len(keys))
+		for i, key := range keys {
+			fields[i] = slog.Any(key, data[key])
+		}
+		attrs = append(attrs, slog.Attr{Key: "data", Value: slog.GroupValue(fields...)})
+	}
+	if len(e.StackTrace) > 0 {
+		attrs = append(attrs, slog.Any("stack", e.StackTrace))
+	}
+	if e.Inner != nil && depth+1 < maxRenderDepth {
+		attrs = append(attrs, slog.Attr{Key: "inner", Value: e.Inner.logValue(depth + 1)})
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// ExceptionAttr returns an attribute holding ex, logged as the group of LogValue, or
+// an empty attribute, which handlers ignore, when ex is nil
+func ExceptionAttr(key string, ex *Exception) slog.Attr {
+	if ex == nil {
+		return slog.Attr{}
+	}
+	return slog.Any(key, *ex)
+}
+
+// LogException logs ex with its message under the "exception" attribute, at the
+// level LogLevelFor gives it when handled, and in the context of the Try that
+// captured it. Extra args are added as with slog.Logger.Log:
+//
+//	Try(sync).Any(func(full Exception) { LogException(logger, &full, "job", name) })
+func LogException(logger *slog.Logger, ex *Exception, args ...any) {
+	if ex == nil {
+		return
+	}
+	level := LogLevelFor(ex, true)
+	ctx := ex.Context()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+	logger.Log(ctx, level, ex.Error(), append([]any{ExceptionAttr("exception", ex)}, args...)...)
+}
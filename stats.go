@@ -0,0 +1,347 @@
+package goexceptions
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// STATS: Per-fingerprint counters of caught exceptions
+// ============================================================================
+
+// ExceptionStats counts the exceptions caught for one fingerprint
+type ExceptionStats struct {
+	TypeName    string
+	Fingerprint string
+	Origin      string
+	Count       int64
+	First       time.Time
+	Last        time.Time
+}
+
+type statsEntry struct {
+	stats ExceptionStats
+
+	// burst detection state, only maintained while a detector is installed
+	windowStart time.Time
+	windowCount int
+	windows     int
+	baseline    float64
+	fired       bool
+}
+
+var statsMutex sync.Mutex
+var statsByFingerprint = make(map[string]*statsEntry)
+
+// Stats returns a copy of the counters of every fingerprint caught so far
+func Stats() []ExceptionStats {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	result := make([]ExceptionStats, 0, len(statsByFingerprint))
+	for _, entry := range statsByFingerprint {
+		result = append(result, entry.stats)
+	}
+	return result
+}
+
+// fingerprintCount returns how many exceptions with the fingerprint were caught
+func fingerprintCount(fingerprint string) int64 {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	if entry, exists := statsByFingerprint[fingerprint]; exists {
+		return entry.stats.Count
+	}
+	return 0
+}
+
+// ResetStats forgets all counters and burst baselines
+func ResetStats() {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	statsByFingerprint = make(map[string]*statsEntry)
+	statsByOperation = make(map[operationKey]*operationEntry)
+}
+
+// recordStats counts an exception caught by Try and feeds the burst detector
+func recordStats(ex *Exception, tenant, operation string, now time.Time) {
+	fingerprint := ex.Fingerprint()
+
+	statsMutex.Lock()
+	entry, exists := statsByFingerprint[fingerprint]
+	if !exists {
+		entry = &statsEntry{stats: ExceptionStats{
+			TypeName:    ex.TypeName(),
+			Fingerprint: fingerprint,
+			Origin:      ex.Origin,
+			First:       now,
+		}}
+		statsByFingerprint[fingerprint] = entry
+	}
+	entry.stats.Count++
+	entry.stats.Last = now
+	burst := observeBurst(entry, ex, now)
+	recordOperationStats(operationKey{tenant, operation, entry.stats.TypeName, fingerprint}, now)
+	statsMutex.Unlock()
+
+	if burst != nil {
+		reportBurst(*burst)
+	}
+}
+
+// ============================================================================
+// OPERATION STATS: Counts and rates per tenant, operation, type and fingerprint
+// ============================================================================
+
+// OperationStats counts the exceptions caught for one fingerprint by the Trys of one
+// operation (see WithName) and tenant (see WithTenant)
+type OperationStats struct {
+	Tenant      string // "" for Trys without a tenant
+	Operation   string // "" for unnamed Trys
+	TypeName    string
+	Fingerprint string
+	Count       int64
+	Last        time.Time
+	Rate        float64 // exceptions per second over the last stats window
+}
+
+type operationKey struct {
+	tenant, operation, typeName, fingerprint string
+}
+
+type operationEntry struct {
+	count       int64
+	last        time.Time
+	windowStart time.Time
+	current     int64 // exceptions in the window starting at windowStart
+	previous    int64 // exceptions in the window before
+}
+
+const defaultStatsWindow = time.Minute
+
+var statsWindow = defaultStatsWindow
+var statsByOperation = make(map[operationKey]*operationEntry)
+
+// SetStatsWindow sets the window over which StatsByOperation computes rates (one
+// minute by default)
+func SetStatsWindow(d time.Duration) {
+	if d <= 0 {
+		d = defaultStatsWindow
+	}
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	statsWindow = d
+}
+
+// StatsByOperation returns a snapshot of the counters per tenant, operation,
+// exception type and fingerprint, sorted in that order, with their current rates
+func StatsByOperation() []OperationStats {
+	return operationStats(func(operationKey) bool { return true })
+}
+
+// TenantStatsByOperation is StatsByOperation for the Trys of one tenant, so a noisy
+// tenant does not skew the counters of the others
+func TenantStatsByOperation(tenant string) []OperationStats {
+	return operationStats(func(key operationKey) bool { return key.tenant == tenant })
+}
+
+func operationStats(include func(key operationKey) bool) []OperationStats {
+	now := time.Now()
+
+	statsMutex.Lock()
+	result := make([]OperationStats, 0, len(statsByOperation))
+	for key, entry := range statsByOperation {
+		if !include(key) {
+			continue
+		}
+		entry.advance(now)
+		result = append(result, OperationStats{
+			Tenant:      key.tenant,
+			Operation:   key.operation,
+			TypeName:    key.typeName,
+			Fingerprint: key.fingerprint,
+			Count:       entry.count,
+			Last:        entry.last,
+			Rate:        entry.rate(now),
+		})
+	}
+	statsMutex.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Tenant != b.Tenant {
+			return a.Tenant < b.Tenant
+		}
+		if a.Operation != b.Operation {
+			return a.Operation < b.Operation
+		}
+		if a.TypeName != b.TypeName {
+			return a.TypeName < b.TypeName
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+	return result
+}
+
+// recordOperationStats counts an exception for its operation. It must be called with
+// statsMutex held.
+func recordOperationStats(key operationKey, now time.Time) {
+	entry, exists := statsByOperation[key]
+	if !exists {
+		entry = &operationEntry{windowStart: now}
+		statsByOperation[key] = entry
+	}
+	entry.advance(now)
+	entry.count++
+	entry.current++
+	entry.last = now
+}
+
+// advance moves the entry's windows up to now. It must be called with statsMutex held.
+func (entry *operationEntry) advance(now time.Time) {
+	elapsed := now.Sub(entry.windowStart)
+	switch {
+	case elapsed < statsWindow:
+		return
+	case elapsed < 2*statsWindow:
+		entry.previous = entry.current
+		entry.windowStart = entry.windowStart.Add(statsWindow)
+	default:
+		entry.previous = 0
+		entry.windowStart = now.Add(-(elapsed % statsWindow))
+	}
+	entry.current = 0
+}
+
+// rate estimates the exceptions per second over the window ending now, weighting the
+// previous window by how much of it the sliding window still covers
+func (entry *operationEntry) rate(now time.Time) float64 {
+	covered := 1 - float64(now.Sub(entry.windowStart))/float64(statsWindow)
+	return (float64(entry.previous)*covered + float64(entry.current)) / statsWindow.Seconds()
+}
+
+// ============================================================================
+// BURST DETECTION: Early warning when a fingerprint spikes above its baseline
+// ============================================================================
+
+// Burst describes a fingerprint whose rate exceeded its baseline
+type Burst struct {
+	TypeName    string
+	Fingerprint string
+	Count       int     // exceptions in the current window
+	Baseline    float64 // smoothed count of previous windows
+	Window      time.Duration
+	Time        time.Time
+	Exception   *Exception // the exception that crossed the threshold
+}
+
+// BurstDetector configures burst detection. A fingerprint bursts when its count within
+// one Window reaches MinCount and exceeds Multiple times its baseline. The baseline is
+// learned from the previous windows, so fingerprints are never reported during their
+// first window. OnBurst is called at most once per fingerprint and window.
+type BurstDetector struct {
+	Window   time.Duration
+	Multiple float64
+	MinCount int
+	OnBurst  func(burst Burst)
+}
+
+// burstSmoothing is the weight of the latest window in the baseline
+const burstSmoothing = 0.3
+
+var burstDetector *BurstDetector
+
+// DetectBursts installs the burst detector and returns a function that removes it.
+// Installing a detector replaces the previous one and restarts every baseline.
+func DetectBursts(detector BurstDetector) (stop func()) {
+	if detector.Window <= 0 {
+		detector.Window = time.Minute
+	}
+	if detector.Multiple <= 0 {
+		detector.Multiple = 3
+	}
+	if detector.MinCount < 1 {
+		detector.MinCount = 1
+	}
+	installed := &detector
+
+	statsMutex.Lock()
+	burstDetector = installed
+	for _, entry := range statsByFingerprint {
+		entry.windowStart, entry.windowCount, entry.windows, entry.baseline, entry.fired = time.Time{}, 0, 0, 0, false
+	}
+	statsMutex.Unlock()
+
+	return func() {
+		statsMutex.Lock()
+		defer statsMutex.Unlock()
+		if burstDetector == installed {
+			burstDetector = nil
+		}
+	}
+}
+
+// observeBurst advances the entry's windows and returns a burst to report, if any.
+// It must be called with statsMutex held.
+func observeBurst(entry *statsEntry, ex *Exception, now time.Time) *Burst {
+	detector := burstDetector
+	if detector == nil {
+		return nil
+	}
+
+	if entry.windowStart.IsZero() {
+		entry.windowStart = now
+	}
+	for elapsed := 0; now.Sub(entry.windowStart) >= detector.Window; elapsed++ {
+		if elapsed >= 64 {
+			// long silence: the baseline has decayed to nothing anyway
+			entry.baseline = 0
+			entry.windowStart = now
+			break
+		}
+		entry.closeWindow()
+		entry.windowStart = entry.windowStart.Add(detector.Window)
+	}
+
+	entry.windowCount++
+	if entry.fired || entry.windows == 0 || entry.windowCount < detector.MinCount {
+		return nil
+	}
+	if float64(entry.windowCount) <= detector.Multiple*entry.baseline {
+		return nil
+	}
+
+	entry.fired = true
+	return &Burst{
+		TypeName:    entry.stats.TypeName,
+		Fingerprint: entry.stats.Fingerprint,
+		Count:       entry.windowCount,
+		Baseline:    entry.baseline,
+		Window:      detector.Window,
+		Time:        now,
+		Exception:   ex,
+	}
+}
+
+func (entry *statsEntry) closeWindow() {
+	if entry.windows == 0 {
+		entry.baseline = float64(entry.windowCount)
+	} else {
+		entry.baseline = burstSmoothing*float64(entry.windowCount) + (1-burstSmoothing)*entry.baseline
+	}
+	entry.windows++
+	entry.windowCount = 0
+	entry.fired = false
+}
+
+func reportBurst(burst Burst) {
+	statsMutex.Lock()
+	detector := burstDetector
+	statsMutex.Unlock()
+	if detector == nil || detector.OnBurst == nil {
+		return
+	}
+
+	defer func() { recover() }()
+	detector.OnBurst(burst)
+}
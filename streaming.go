@@ -0,0 +1,114 @@
+package goexceptions
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// ============================================================================
+// STREAMED RESPONSES: Report exceptions thrown after the response started
+// ============================================================================
+
+// Trailers set by StreamResponse. StreamStatusTrailer holds the status code of the
+// stream: the one sent in the header when it completed, the one chosen by
+// HTTPStatusFor when an exception interrupted it. StreamErrorTrailer holds the code
+// of that exception.
+const (
+	StreamStatusTrailer = "X-Stream-Status"
+	StreamErrorTrailer  = "X-Stream-Error"
+)
+
+// StreamError describes the exception that interrupted a streamed response
+type StreamError struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"` // Code field of the exception, or its TypeName
+	Message string `json:"message"`
+}
+
+// streamErrorLine is the last object written to an interrupted stream
+type streamErrorLine struct {
+	Error StreamError `json:"error"`
+}
+
+// StreamResponse runs block, which streams a response to w, such as newline-delimited
+// JSON flushed as it goes. When block throws, the client is told instead of seeing
+// the stream end early:
+//
+//   - before anything was written, the response is the StreamError as a JSON body,
+//     with the status code chosen by HTTPStatusFor;
+//   - after that, a last line {"error": {...}} is written and the trailers say the
+//     stream failed (see StreamStatusTrailer).
+//
+// The result holds the exception, still to be handled, typically by logging it:
+//
+//	StreamResponse(w, func(w http.ResponseWriter) {
+//	    for rows.Next() {
+//	        json.NewEncoder(w).Encode(scan(rows))
+//	        http.NewResponseController(w).Flush()
+//	    }
+//	}).Any(logIt).End()
+func StreamResponse(w http.ResponseWriter, block func(w http.ResponseWriter), opts ...TryOption) *TryResult {
+	w.Header().Add("Trailer", StreamStatusTrailer)
+	w.Header().Add("Trailer", StreamErrorTrailer)
+	stream := &streamWriter{ResponseWriter: w}
+
+	tr := Try(func() {
+		block(stream)
+	}, opts...)
+
+	ex := tr.exception
+	if ex == nil {
+		w.Header().Set(StreamStatusTrailer, strconv.Itoa(stream.statusOrOK()))
+		return tr
+	}
+
+	streamErr := StreamError{Status: HTTPStatusFor(ex), Code: responseCode(ex), Message: ex.Error()}
+	if !stream.started {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(streamErr.Status)
+		json.NewEncoder(w).Encode(streamErr)
+		return tr
+	}
+	json.NewEncoder(w).Encode(streamErrorLine{Error: streamErr})
+	w.Header().Set(StreamStatusTrailer, strconv.Itoa(streamErr.Status))
+	w.Header().Set(StreamErrorTrailer, streamErr.Code)
+	return tr
+}
+
+// streamWriter records whether the response started
+type streamWriter struct {
+	http.ResponseWriter
+	started bool
+	status  int
+}
+
+func (sw *streamWriter) WriteHeader(status int) {
+	if !sw.started {
+		sw.started, sw.status = true, status
+	}
+	sw.ResponseWriter.WriteHeader(status)
+}
+
+func (sw *streamWriter) Write(p []byte) (int, error) {
+	sw.started = true
+	return sw.ResponseWriter.Write(p)
+}
+
+// Flush lets http.Flusher type assertions through
+func (sw *streamWriter) Flush() {
+	sw.started = true
+	http.NewResponseController(sw.ResponseWriter).Flush()
+}
+
+// Unwrap gives http.ResponseController access to the underlying writer
+func (sw *streamWriter) Unwrap() http.ResponseWriter {
+	return sw.ResponseWriter
+}
+
+func (sw *streamWriter) statusOrOK() int {
+	if sw.status == 0 {
+		return http.StatusOK
+	}
+	return sw.status
+}
@@ -0,0 +1,51 @@
+package goexceptions
+
+import "sync/atomic"
+
+// ============================================================================
+// STRICT MODE: Unhandled exceptions escape instead of being swallowed
+// ============================================================================
+
+var strictMode atomic.Bool
+
+// SetStrictMode makes chains throw their exception again when they end (Finally,
+// End, FinallyWithin) without a handler consuming it, instead of swallowing it. It is
+// also the "strict" subsystem of Configure. WithStrict overrides it per Try.
+func SetStrictMode(enabled bool) {
+	strictMode.Store(enabled)
+}
+
+// StrictMode reports whether strict mode is enabled
+func StrictMode() bool {
+	return strictMode.Load()
+}
+
+// WithStrict enables or disables strict mode for this Try, whatever the global setting:
+//
+//	Try(migrate, WithStrict(true)).Handle(Handler[LockException](wait)).End() // other exceptions escape
+func WithStrict(strict bool) TryOption {
+	return func(c *tryConfig) {
+		c.strictSet = true
+		c.strict = strict
+	}
+}
+
+func (tr *TryResult) isStrict() bool {
+	if tr.config.strictSet {
+		return tr.config.strict
+	}
+	return strictMode.Load()
+}
+
+// completeStrict ends the chain like complete, then throws an unhandled exception
+// again, with its original stack trace, when the Try is strict
+func (tr *TryResult) completeStrict() {
+	if tr.completed || tr.exception == nil || tr.handled || !tr.isStrict() {
+		tr.complete()
+		return
+	}
+	exception := *tr.exception
+	exception.owner = nil
+	tr.complete()
+	panic(exception)
+}
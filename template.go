@@ -0,0 +1,93 @@
+package goexceptions
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	texttemplate "text/template"
+)
+
+// ============================================================================
+// TEMPLATES: Panic-safe html/template and text/template execution
+// ============================================================================
+
+// TemplateException reports a failed template execution
+type TemplateException struct {
+	Template string
+	Node     string // template action that failed, e.g. ".User.Name", when known
+	Line     int
+	Column   int
+	Message  string
+	Cause    error
+}
+
+func (e TemplateException) Error() string {
+	msg := fmt.Sprintf("TemplateException: %s (Template: %s", e.Message, e.Template)
+	if e.Line > 0 {
+		msg += fmt.Sprintf(", Line: %d, Column: %d", e.Line, e.Column)
+	}
+	if e.Node != "" {
+		msg += fmt.Sprintf(", Node: %s", e.Node)
+	}
+	return msg + ")"
+}
+
+func (e TemplateException) TypeName() string {
+	return "TemplateException"
+}
+
+// TemplateExecutor is implemented by both *text/template.Template and *html/template.Template
+type TemplateExecutor interface {
+	Name() string
+	Execute(w io.Writer, data any) error
+}
+
+// executionErrorPattern matches "template: name:line:col: executing "name" at <node>: ..."
+var executionErrorPattern = regexp.MustCompile(`^template: .*?:(\d+):(\d+): executing ".*?" at <(.*?)>: `)
+
+// ExecuteTemplate executes t into w, throwing a TemplateException if execution fails
+// or panics. Output already written to w before the failure is not rolled back; use
+// RenderTemplate to render into memory first.
+func ExecuteTemplate(t TemplateExecutor, w io.Writer, data any) {
+	var execErr error
+	tr := Try(func() {
+		execErr = t.Execute(w, data)
+	})
+
+	if ex := tr.settle(); ex != nil {
+		ThrowWithInner(TemplateException{
+			Template: t.Name(),
+			Message:  "template execution panicked",
+		}, ex)
+	}
+	if execErr != nil {
+		Throw(templateException(t.Name(), execErr))
+	}
+}
+
+// RenderTemplate executes t into memory and returns the output, so a failure never
+// leaves a partially written response behind
+func RenderTemplate(t TemplateExecutor, data any) string {
+	var buf bytes.Buffer
+	ExecuteTemplate(t, &buf, data)
+	return buf.String()
+}
+
+func templateException(name string, err error) TemplateException {
+	ex := TemplateException{Template: name, Message: err.Error(), Cause: err}
+
+	var execErr texttemplate.ExecError
+	if errors.As(err, &execErr) {
+		ex.Template = execErr.Name
+	}
+	if match := executionErrorPattern.FindStringSubmatch(err.Error()); match != nil {
+		ex.Line, _ = strconv.Atoi(match[1])
+		ex.Column, _ = strconv.Atoi(match[2])
+		ex.Node = match[3]
+		ex.Message = err.Error()[len(match[0]):]
+	}
+	return ex
+}
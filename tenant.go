@@ -0,0 +1,113 @@
+package goexceptions
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// TENANTS: Partition reporting per tenant
+// ============================================================================
+
+// TenantExtractor derives the tenant of a Try from its context
+type TenantExtractor func(ctx context.Context) string
+
+var tenantMutex sync.RWMutex
+var tenantExtractor TenantExtractor
+
+// SetTenantExtractor installs the function deriving tenants from the context given
+// with WithContext. Pass nil to remove it.
+func SetTenantExtractor(extractor TenantExtractor) {
+	tenantMutex.Lock()
+	defer tenantMutex.Unlock()
+	tenantExtractor = extractor
+}
+
+// WithTenant tags the Try with a tenant explicitly, taking precedence over the extractor
+func WithTenant(tenant string) TryOption {
+	return func(c *tryConfig) {
+		c.tenant = tenant
+	}
+}
+
+// Tenant returns the tenant of the Try: the one given with WithTenant, otherwise the
+// one extracted from its context, otherwise ""
+func (tr *TryResult) Tenant() string {
+	if tr == nil {
+		return ""
+	}
+	if tr.config.tenant != "" || tr.config.ctx == nil {
+		return tr.config.tenant
+	}
+
+	tenantMutex.RLock()
+	extractor := tenantExtractor
+	tenantMutex.RUnlock()
+	if extractor == nil {
+		return ""
+	}
+	return extractor(tr.config.ctx)
+}
+
+// ============================================================================
+// PER-TENANT REPORTING BUDGET
+// ============================================================================
+
+type tenantBudget struct {
+	windowStart time.Time
+	delivered   int
+	dropped     int
+}
+
+var budgetMutex sync.Mutex
+var budgetLimit int
+var budgetWindow time.Duration
+var tenantBudgets = make(map[string]*tenantBudget)
+
+// SetTenantBudget limits every tenant to maxEvents observer events per window, so a
+// single noisy tenant cannot flood reporters. Events without a tenant are not limited.
+// A zero maxEvents removes the limit.
+func SetTenantBudget(maxEvents int, window time.Duration) {
+	budgetMutex.Lock()
+	defer budgetMutex.Unlock()
+	budgetLimit = maxEvents
+	budgetWindow = window
+	tenantBudgets = make(map[string]*tenantBudget)
+}
+
+// TenantDropped returns how many events of the tenant were dropped by its budget
+// in the current window
+func TenantDropped(tenant string) int {
+	budgetMutex.Lock()
+	defer budgetMutex.Unlock()
+	if budget, exists := tenantBudgets[tenant]; exists {
+		return budget.dropped
+	}
+	return 0
+}
+
+// admitTenant charges one event to the tenant's budget
+func admitTenant(tenant string, now time.Time) bool {
+	if tenant == "" {
+		return true
+	}
+
+	budgetMutex.Lock()
+	defer budgetMutex.Unlock()
+	if budgetLimit <= 0 {
+		return true
+	}
+
+	budget, exists := tenantBudgets[tenant]
+	if !exists || now.Sub(budget.windowStart) >= budgetWindow {
+		budget = &tenantBudget{windowStart: now}
+		tenantBudgets[tenant] = budget
+	}
+	if budget.delivered >= budgetLimit {
+		budget.dropped++
+		return false
+	}
+	budget.delivered++
+	return true
+}
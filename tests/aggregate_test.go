@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestAggregateException(t *testing.T) {
+	failure := func(block func()) *Exception {
+		return Try(block).GetException()
+	}
+	nested := AggregateException{Message: "shard 2", Exceptions: []*Exception{
+		failure(func() { ThrowIOError("read", "truncated", io.ErrUnexpectedEOF) }),
+		failure(func() { ThrowInvalidOperation("closed") }),
+	}}
+	aggregate := AggregateException{Message: "import failed", Exceptions: []*Exception{
+		failure(func() { ThrowArgumentNull("id", "missing") }),
+		failure(func() { Throw(nested) }),
+	}}
+
+	t.Run("Flatten", func(t *testing.T) {
+		flat := aggregate.Flatten()
+		if flat.Message != "import failed" || len(flat.Exceptions) != 3 {
+			t.Fatalf("Expected 3 exceptions, got %s", flat.Error())
+		}
+		names := []string{flat.Exceptions[0].TypeName(), flat.Exceptions[1].TypeName(), flat.Exceptions[2].TypeName()}
+		if names[0] != "ArgumentNullException" || names[1] != "IOException" || names[2] != "InvalidOperationException" {
+			t.Errorf("Unexpected order %v", names)
+		}
+	})
+
+	t.Run("Range stops early", func(t *testing.T) {
+		var visited int
+		aggregate.Range(func(ex *Exception) bool {
+			visited++
+			return ex.TypeName() != "IOException"
+		})
+		if visited != 2 {
+			t.Errorf("Expected to stop at the IOException, visited %d", visited)
+		}
+	})
+
+	t.Run("errors.Is and errors.As search every exception", func(t *testing.T) {
+		err := failure(func() { Throw(aggregate) }).ToError()
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Error("Expected errors.Is to find the nested cause")
+		}
+		var invalid InvalidOperationException
+		if !errors.As(err, &invalid) || invalid.Message != "closed" {
+			t.Errorf("Expected errors.As to find the InvalidOperationException, got %+v", invalid)
+		}
+	})
+
+	t.Run("Caught as a whole", func(t *testing.T) {
+		var count int
+		Try(func() { Throw(aggregate) }).Handle(
+			Handler[AggregateException](func(ex AggregateException, full Exception) {
+				count = len(ex.Flatten().Exceptions)
+			}),
+		)
+		if count != 3 {
+			t.Errorf("Expected 3 exceptions, got %d", count)
+		}
+	})
+}
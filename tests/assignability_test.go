@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestHandlerByInterface(t *testing.T) {
+	t.Run("ArgumentException catches both argument exceptions", func(t *testing.T) {
+		var names []string
+		for _, block := range []func(){
+			func() { ThrowArgumentNull("email", "") },
+			func() { ThrowArgumentOutOfRange("age", 200, "") },
+		} {
+			Try(block).Handle(
+				Handler[ArgumentException](func(ex ArgumentException, full Exception) {
+					names = append(names, ex.ArgumentName())
+				}),
+			)
+		}
+		if len(names) != 2 || names[0] != "email" || names[1] != "age" {
+			t.Errorf("Expected both arguments, got %v", names)
+		}
+	})
+
+	t.Run("ExceptionType acts as catch-all", func(t *testing.T) {
+		var caught string
+		Try(func() { ThrowInvalidOperation("no") }).Handle(
+			Handler[ArgumentException](func(ex ArgumentException, full Exception) { caught = "argument" }),
+			Handler[ExceptionType](func(ex ExceptionType, full Exception) { caught = ex.TypeName() }),
+		)
+		if caught != "InvalidOperationException" {
+			t.Errorf("Expected the catch-all, got %q", caught)
+		}
+	})
+
+	t.Run("Catch, On and FindInnerException", func(t *testing.T) {
+		var caught, on bool
+		Catch(Try(func() { ThrowArgumentNull("id", "") }), func(ex ArgumentException, full Exception) { caught = true })
+		On(Try(func() { ThrowArgumentNull("id", "") }).When(), func(ex ArgumentException, full Exception) { on = true }).End()
+		if !caught || !on {
+			t.Errorf("Expected Catch and On to match by interface: %v %v", caught, on)
+		}
+
+		inner := &Exception{Type: ArgumentOutOfRangeException{ParamName: "page"}}
+		outer := &Exception{Type: InvalidOperationException{Message: "list"}, Inner: inner}
+		if found := FindInnerException[ArgumentException](outer); found == nil || (*found).ArgumentName() != "page" {
+			t.Error("Expected FindInnerException to match by interface")
+		}
+	})
+
+	t.Run("MatchExact stays exact", func(t *testing.T) {
+		ex := &Exception{Type: ArgumentNullException{ParamName: "id"}}
+		if MatchExact[ArgumentException]().Match(ex) || !MatchExact[ArgumentNullException]().Match(ex) {
+			t.Error("MatchExact should only match the exact type")
+		}
+	})
+}
+
+type argumentController struct {
+	handledBy string
+}
+
+func (c *argumentController) HandleArgument(ex ArgumentException, full Exception) {
+	c.handledBy = "HandleArgument:" + ex.ArgumentName()
+}
+
+func (c *argumentController) HandleNull(ex ArgumentNullException, full Exception) {
+	c.handledBy = "HandleNull"
+}
+
+func TestHandlersFromByInterface(t *testing.T) {
+	c := &argumentController{}
+	Try(func() { ThrowArgumentNull("id", "") }).Handle(HandlersFrom(c)...)
+	if c.handledBy != "HandleNull" {
+		t.Errorf("Expected the concrete method first, got %q", c.handledBy)
+	}
+
+	Try(func() { ThrowArgumentOutOfRange("page", -1, "") }).Handle(HandlersFrom(c)...)
+	if c.handledBy != "HandleArgument:page" {
+		t.Errorf("Expected the interface method, got %q", c.handledBy)
+	}
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestAsyncObserver(t *testing.T) {
+	t.Run("Slow observers do not block the caller", func(t *testing.T) {
+		var delivered atomic.Int32
+		slow := ObserverFunc(func(event Event) {
+			time.Sleep(20 * time.Millisecond)
+			delivered.Add(1)
+		})
+		async := NewAsyncObserver(slow, AsyncConfig{})
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			Try(func() { ThrowInvalidOperation("boom") }, WithObserver(async)).End()
+		}
+		if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+			t.Errorf("Throwing waited for the observer: %v", elapsed)
+		}
+
+		if err := async.Close(context.Background()); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+		if delivered.Load() != 10 {
+			t.Errorf("Expected 10 events after Close, got %d", delivered.Load())
+		}
+	})
+
+	t.Run("Drop policies bound the queue", func(t *testing.T) {
+		for _, c := range []struct {
+			policy   DropPolicy
+			expected []string
+		}{
+			{DropNewest, []string{"first", "second"}},
+			{DropOldest, []string{"first", "fourth"}},
+		} {
+			release := make(chan struct{})
+			started := make(chan struct{})
+			var mu sync.Mutex
+			var names []string
+			var onDrop []string
+			async := NewAsyncObserver(ObserverFunc(func(event Event) {
+				if event.Name == "first" {
+					close(started)
+					<-release
+				}
+				mu.Lock()
+				names = append(names, event.Name)
+				mu.Unlock()
+			}), AsyncConfig{QueueSize: 1, Drop: c.policy, OnDrop: func(event Event) { onDrop = append(onDrop, event.Name) }})
+
+			async.Observe(Event{Name: "first"})
+			<-started
+			async.Observe(Event{Name: "second"})
+			async.Observe(Event{Name: "third"})
+			async.Observe(Event{Name: "fourth"})
+			close(release)
+			async.Close(context.Background())
+
+			mu.Lock()
+			if len(names) != len(c.expected) || names[0] != c.expected[0] || names[1] != c.expected[1] {
+				t.Errorf("Policy %d: expected %v, got %v", c.policy, c.expected, names)
+			}
+			mu.Unlock()
+			if async.Dropped() != 2 || len(onDrop) != 2 {
+				t.Errorf("Policy %d: expected 2 drops, got %d (%v)", c.policy, async.Dropped(), onDrop)
+			}
+		}
+	})
+
+	t.Run("BlockWhenFull delivers everything", func(t *testing.T) {
+		var delivered atomic.Int32
+		async := NewAsyncObserver(ObserverFunc(func(event Event) { delivered.Add(1) }),
+			AsyncConfig{QueueSize: 2, Drop: BlockWhenFull})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					async.Observe(Event{})
+				}
+			}()
+		}
+		wg.Wait()
+		if err := async.Flush(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if delivered.Load() != 400 || async.Dropped() != 0 {
+			t.Errorf("Expected 400 deliveries without drops, got %d and %d", delivered.Load(), async.Dropped())
+		}
+		async.Close(context.Background())
+	})
+
+	t.Run("Flush honors the context and Close delivers inline afterwards", func(t *testing.T) {
+		release := make(chan struct{})
+		var delivered atomic.Int32
+		async := NewAsyncObserver(ObserverFunc(func(event Event) {
+			if event.Name == "stuck" {
+				<-release
+			}
+			delivered.Add(1)
+		}), AsyncConfig{})
+
+		async.Observe(Event{Name: "stuck"})
+		if err := async.Flush(expired()); err == nil {
+			t.Error("Flush should give up when the context is done")
+		}
+		if err := async.Close(expired()); err == nil {
+			t.Error("Close should give up when the context is done")
+		}
+		close(release)
+
+		async.Observe(Event{Name: "late"})
+		if delivered.Load() < 1 {
+			t.Error("Events after Close should be delivered inline")
+		}
+	})
+
+	t.Run("Exceptions are copied before queueing", func(t *testing.T) {
+		received := make(chan *Exception, 1)
+		async := NewAsyncObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled {
+				received <- event.Exception
+			}
+		}), AsyncConfig{})
+		defer async.Close(context.Background())
+
+		tr := Try(func() { ThrowArgumentNull("id", "missing") }, WithObserver(async)).End()
+		tr.GetException().Type = InvalidOperationException{}
+		if ex := <-received; ex.TypeName() != "ArgumentNullException" {
+			t.Errorf("Expected the exception intact, got %s", ex.TypeName())
+		}
+	})
+}
+
+func expired() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
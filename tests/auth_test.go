@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestAuthExceptions(t *testing.T) {
+	t.Run("Authentication", func(t *testing.T) {
+		var caught AuthenticationException
+		tr := Try(func() {
+			ThrowAuthentication("john.doe", "token expired")
+		}).Handle(Handler[AuthenticationException](func(ex AuthenticationException, full Exception) {
+			caught = ex
+		}))
+
+		if caught.Subject != "john.doe" || caught.Error() != "AuthenticationException: token expired (Subject: john.doe)" {
+			t.Errorf("Unexpected exception: %v", caught)
+		}
+		if status := HTTPStatusFor(tr.GetException()); status != http.StatusUnauthorized {
+			t.Errorf("Expected 401, got %d", status)
+		}
+		if (AuthenticationException{Message: "missing credentials"}).Error() != "AuthenticationException: missing credentials" {
+			t.Error("Anonymous subject should be omitted")
+		}
+	})
+
+	t.Run("Authorization", func(t *testing.T) {
+		ex := Try(func() {
+			ThrowAuthorization("john.doe", "invoice/42", "delete")
+		}).GetException()
+
+		denied, ok := ex.Type.(AuthorizationException)
+		if !ok || denied.Subject != "john.doe" || denied.Resource != "invoice/42" || denied.Action != "delete" {
+			t.Fatalf("Unexpected exception: %v", ex)
+		}
+		if HTTPStatusFor(ex) != http.StatusForbidden {
+			t.Errorf("Expected 403, got %d", HTTPStatusFor(ex))
+		}
+	})
+}
+
+func TestHTTPStatusFor(t *testing.T) {
+	capture := func(block func()) *Exception {
+		return Try(block).GetException()
+	}
+
+	if status := HTTPStatusFor(capture(func() { ThrowArgumentNull("id", "") })); status != http.StatusBadRequest {
+		t.Errorf("Expected 400, got %d", status)
+	}
+	if status := HTTPStatusFor(capture(func() { ThrowInvalidOperation("boom") })); status != http.StatusInternalServerError {
+		t.Errorf("Expected 500, got %d", status)
+	}
+	if status := HTTPStatusFor(nil); status != http.StatusInternalServerError {
+		t.Errorf("Expected 500 for nil, got %d", status)
+	}
+
+	RegisterHTTPStatus[AuthorizationException](http.StatusNotFound)
+	denied := capture(func() { ThrowAuthorization("eve", "doc/1", "read") })
+	if status := HTTPStatusFor(denied); status != http.StatusNotFound {
+		t.Errorf("Registered status should win, got %d", status)
+	}
+	UnregisterHTTPStatus[AuthorizationException]()
+	if status := HTTPStatusFor(denied); status != http.StatusForbidden {
+		t.Errorf("Unregister should restore the default, got %d", status)
+	}
+}
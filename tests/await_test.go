@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestAwaitOrThrow(t *testing.T) {
+	t.Run("Delivers values", func(t *testing.T) {
+		ch := make(chan int, 1)
+		ch <- 7
+		if value := AwaitOrThrow(ch, time.Second, "result"); value != 7 {
+			t.Errorf("Expected 7, got %d", value)
+		}
+	})
+
+	t.Run("Throws TimeoutException", func(t *testing.T) {
+		ch := make(chan int)
+		var timeout TimeoutException
+		Try(func() {
+			AwaitOrThrow(ch, 10*time.Millisecond, "pricing")
+		}).Handle(Handler[TimeoutException](func(ex TimeoutException, full Exception) {
+			timeout = ex
+		}))
+
+		if timeout.Operation != "pricing" || timeout.Timeout != 10*time.Millisecond || timeout.Elapsed < timeout.Timeout {
+			t.Errorf("Unexpected timeout: %+v", timeout)
+		}
+	})
+
+	t.Run("Closed channel is a cancellation", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+		ex := Try(func() { AwaitOrThrow(ch, time.Second, "closed") }).GetException()
+		if ex == nil || ex.TypeName() != "OperationCanceledException" {
+			t.Errorf("Expected OperationCanceledException, got %v", ex)
+		}
+	})
+}
+
+func TestReceiveCtx(t *testing.T) {
+	t.Run("Deadline becomes TimeoutException", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		ex := Try(func() { ReceiveCtx(ctx, make(chan string)) }).GetException()
+		if ex == nil || ex.TypeName() != "TimeoutException" {
+			t.Errorf("Expected TimeoutException, got %v", ex)
+		}
+	})
+
+	t.Run("Cancel becomes OperationCanceledException", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ex := Try(func() { ReceiveCtx(ctx, make(chan string)) }).GetException()
+		canceled, ok := ex.Type.(OperationCanceledException)
+		if !ok || canceled.Reason != "context canceled" {
+			t.Errorf("Expected OperationCanceledException, got %v", ex)
+		}
+	})
+
+	t.Run("Cancellation cause becomes the inner exception", func(t *testing.T) {
+		clientGone := errors.New("client disconnected")
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(clientGone)
+
+		var inner *Exception
+		Try(func() {
+			ReceiveCtx(ctx, make(chan string))
+		}).Handle(
+			HandlerSentinel(context.Canceled, func(ex Exception) { inner = ex.Inner }),
+		)
+
+		if inner == nil || inner.Error() != "InvalidOperationException: client disconnected" {
+			t.Fatalf("Expected the cause as inner exception, got %v", inner)
+		}
+		matched := false
+		Try(func() {
+			ReceiveCtx(ctx, make(chan string))
+		}).Handle(HandlerSentinel(clientGone, func(ex Exception) { matched = true }))
+		if !matched {
+			t.Error("The cause should be matchable as a sentinel")
+		}
+	})
+
+	t.Run("Timeout cause becomes the inner exception", func(t *testing.T) {
+		ctx, cancel := context.WithTimeoutCause(context.Background(), 10*time.Millisecond, errors.New("parent budget spent"))
+		defer cancel()
+
+		ex := Try(func() { ReceiveCtx(ctx, make(chan string)) }).GetException()
+		if ex.TypeName() != "TimeoutException" || ex.Inner == nil || ex.Inner.Error() != "InvalidOperationException: parent budget spent" {
+			t.Errorf("Unexpected chain: %s", ex.GetFullMessage())
+		}
+	})
+
+	t.Run("Plain cancellation has no inner exception", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if ex := Try(func() { ReceiveCtx(ctx, make(chan string)) }).GetException(); ex.Inner != nil {
+			t.Errorf("Unexpected inner exception: %v", ex.Inner)
+		}
+	})
+
+	t.Run("Delivers values", func(t *testing.T) {
+		ch := make(chan string, 1)
+		ch <- "ok"
+		if value := ReceiveCtx(context.Background(), ch); value != "ok" {
+			t.Errorf("Expected ok, got %s", value)
+		}
+	})
+}
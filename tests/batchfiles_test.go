@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestProcessFiles(t *testing.T) {
+	paths := []string{"a.csv", "b.csv", "c.csv", "d.csv"}
+	process := func(path string) {
+		switch path {
+		case "b.csv":
+			ThrowFileError(path, "permission denied", nil)
+		case "c.csv":
+			ThrowParseError(4, 2, "x,,", "unexpected field", nil)
+		}
+	}
+
+	t.Run("Failures are recorded per file", func(t *testing.T) {
+		var progress []FileProgress
+		result := ProcessFiles(paths, process, WithFileProgress(func(p FileProgress) {
+			progress = append(progress, p)
+		}))
+
+		if strings.Join(result.Succeeded, ",") != "a.csv,d.csv" {
+			t.Errorf("Unexpected successes: %v", result.Succeeded)
+		}
+		if len(result.Failures) != 2 {
+			t.Fatalf("Expected 2 failures, got %d", len(result.Failures))
+		}
+		if len(progress) != 4 || !progress[1].Failed || progress[3].Index != 3 || progress[3].Total != 4 {
+			t.Errorf("Unexpected progress: %+v", progress)
+		}
+	})
+
+	t.Run("Every failure is a FileException for its path", func(t *testing.T) {
+		result := ProcessFiles(paths, process)
+
+		for _, failure := range result.Failures {
+			fileEx, ok := failure.Exception.Type.(FileException)
+			if !ok || fileEx.Filename != failure.Path {
+				t.Errorf("Expected FileException for %s, got %s", failure.Path, failure.Exception.TypeName())
+			}
+		}
+		parse := FindInnerException[ParseException](result.Failures[1].Exception)
+		if parse == nil || parse.Line != 4 {
+			t.Error("Original exception should be kept as inner exception")
+		}
+	})
+
+	t.Run("Stop on failure leaves the rest pending", func(t *testing.T) {
+		result := ProcessFiles(paths, process, WithStopOnFailure())
+
+		if strings.Join(result.Pending, ",") != "c.csv,d.csv" {
+			t.Errorf("Unexpected pending files: %v", result.Pending)
+		}
+		if strings.Join(result.ResumePaths(), ",") != "b.csv,c.csv,d.csv" {
+			t.Errorf("Unexpected resume paths: %v", result.ResumePaths())
+		}
+	})
+
+	t.Run("Aggregate of failures", func(t *testing.T) {
+		result := ProcessFiles(paths, process)
+
+		var count int
+		Try(func() {
+			result.ThrowIfFailed()
+		}).Handle(
+			Handler[AggregateException](func(ex AggregateException, full Exception) {
+				count = len(ex.Exceptions)
+			}),
+		)
+		if count != 2 {
+			t.Errorf("Expected aggregate of 2 failures, got %d", count)
+		}
+
+		ok := ProcessFiles([]string{"a.csv"}, process)
+		if ok.HasFailures() || ok.Exception() != nil {
+			t.Error("Successful batch should have no exception")
+		}
+		ok.ThrowIfFailed()
+	})
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestBlockingHandlerGuard(t *testing.T) {
+	SetDebugMode(true)
+	defer SetDebugMode(false)
+	SetBlockingHandlerThreshold(20 * time.Millisecond)
+	defer SetBlockingHandlerThreshold(0)
+
+	diagnostics := make(chan Event, 4)
+	observer := WithObserver(ObserverFunc(func(event Event) {
+		if event.Kind == EventDiagnostic {
+			diagnostics <- event
+		}
+	}))
+
+	Try(func() {
+		ThrowInvalidOperation("payment failed")
+	}, observer).Handle(
+		Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+			time.Sleep(60 * time.Millisecond) // synchronous call on the error path
+		}),
+	)
+
+	select {
+	case event := <-diagnostics:
+		blocking, ok := event.Exception.Type.(BlockingHandlerException)
+		if !ok || blocking.Handler != "Handler[InvalidOperationException]" || blocking.Threshold != 20*time.Millisecond {
+			t.Errorf("Unexpected diagnostic: %v", event.Exception)
+		}
+		if event.Exception.Inner == nil || event.Exception.Inner.TypeName() != "InvalidOperationException" {
+			t.Error("Handled exception should be the inner exception")
+		}
+	default:
+		t.Fatal("Blocking handler should be reported")
+	}
+
+	Try(func() {
+		ThrowInvalidOperation("fast")
+	}, observer).Any(func(ex Exception) {})
+	time.Sleep(40 * time.Millisecond)
+	if len(diagnostics) != 0 {
+		t.Error("Fast handlers should not be reported")
+	}
+
+	t.Run("Inactive outside debug mode", func(t *testing.T) {
+		SetDebugMode(false)
+		Try(func() {
+			ThrowInvalidOperation("slow")
+		}, observer).Any(func(ex Exception) { time.Sleep(40 * time.Millisecond) })
+		if len(diagnostics) != 0 {
+			t.Error("Guard should only run in debug mode")
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func fetchOrder(id string) (order string, err error) {
+	return order, Try(func() {
+		if id == "" {
+			ThrowArgumentNull("id", "order id is required")
+		}
+		if id == "io" {
+			ThrowIOError("read", "order store unavailable", io.ErrUnexpectedEOF)
+		}
+		order = "order-" + id
+	}).Handle(
+		Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {}),
+	).AsError()
+}
+
+func TestAsError(t *testing.T) {
+	t.Run("Nil on success", func(t *testing.T) {
+		order, err := fetchOrder("42")
+		if err != nil || order != "order-42" {
+			t.Errorf("Expected order-42, got %q (%v)", order, err)
+		}
+	})
+
+	t.Run("Unhandled exception becomes an error", func(t *testing.T) {
+		_, err := fetchOrder("")
+		var exErr *ExceptionError
+		if !errors.As(err, &exErr) {
+			t.Fatalf("Expected an ExceptionError, got %T", err)
+		}
+		if exErr.TypeName != "ArgumentNullException" || exErr.Message != err.Error() || len(exErr.StackTrace) == 0 {
+			t.Errorf("Unexpected error: %+v", exErr)
+		}
+		var argEx ArgumentNullException
+		if !errors.As(err, &argEx) || argEx.ParamName != "id" {
+			t.Errorf("Expected the exception type through errors.As, got %+v", argEx)
+		}
+	})
+
+	t.Run("Causes survive", func(t *testing.T) {
+		_, err := fetchOrder("io")
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("Expected io.ErrUnexpectedEOF in %v", err)
+		}
+	})
+
+	t.Run("Nil when handled", func(t *testing.T) {
+		err := Try(func() { ThrowInvalidOperation("no") }).Any(func(Exception) {}).AsError()
+		if err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+	})
+
+	t.Run("Snapshot outlives changes to the exception", func(t *testing.T) {
+		tr := Try(func() {
+			ex := Exception{Type: InvalidOperationException{Message: "boom"}, Data: map[string]interface{}{"order": 7}}
+			panic(ex)
+		})
+		exErr := tr.AsError().(*ExceptionError)
+		tr.GetException().Data["order"] = 8
+
+		if exErr.Data["order"] != 7 || exErr.Exception().TypeName() != "InvalidOperationException" {
+			t.Errorf("Expected the snapshot to survive, got %+v", exErr)
+		}
+	})
+}
+
+func TestToError(t *testing.T) {
+	var nilException *Exception
+	if nilException.ToError() != nil {
+		t.Error("Expected nil for a nil exception")
+	}
+
+	ex := &Exception{Type: InvalidOperationException{Message: "boom"}, Data: map[string]interface{}{"k": "v"}}
+	err := ex.ToError()
+	ex.Data["k"] = "changed"
+	if err.(*ExceptionError).Exception().Data["k"] != "v" {
+		t.Error("Expected the data to be copied")
+	}
+}
+
+func TestErr(t *testing.T) {
+	if err := Try(func() {}).Err(); err != nil {
+		t.Errorf("Expected nil on success, got %v", err)
+	}
+
+	t.Run("Handled exceptions are returned too", func(t *testing.T) {
+		result := Try(func() {
+			Throw(TimeoutException{Operation: "sync", Timeout: time.Second})
+		}).Any(func(Exception) {})
+
+		err := result.Err()
+		var timeout TimeoutException
+		if !errors.As(err, &timeout) || timeout.Operation != "sync" {
+			t.Errorf("Expected a TimeoutException, got %v", err)
+		}
+		var pointer *TimeoutException
+		if !errors.As(err, &pointer) || pointer.Operation != "sync" {
+			t.Errorf("Expected a *TimeoutException, got %v", pointer)
+		}
+		var network *NetworkException
+		if errors.As(err, &network) {
+			t.Error("A TimeoutException is not a NetworkException")
+		}
+	})
+
+	t.Run("Embedded parents", func(t *testing.T) {
+		err := Try(throwDeclined).Err()
+		var payment PaymentException
+		if !errors.As(err, &payment) || payment.OrderID != "A-17" {
+			t.Errorf("Expected the embedded PaymentException, got %+v", payment)
+		}
+		var declined *CardDeclinedException
+		if !errors.As(err, &declined) || declined.Card == "" {
+			t.Errorf("Expected a *CardDeclinedException, got %+v", declined)
+		}
+	})
+}
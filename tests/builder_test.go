@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestStagedBuilder(t *testing.T) {
+	t.Run("Any moves the builder to its final stage", func(t *testing.T) {
+		var caught, cleaned bool
+
+		Try(func() {
+			ThrowInvalidOperation("catch-all")
+		}).When().Any(func(ex Exception) {
+			caught = true
+		}).Finally(func() {
+			cleaned = true
+		})
+
+		if !caught || !cleaned {
+			t.Errorf("Expected catch-all and cleanup to run, got caught=%v cleaned=%v", caught, cleaned)
+		}
+	})
+
+	t.Run("Final stage only exposes Finally and End", func(t *testing.T) {
+		builderType := reflect.TypeOf(&CatchAllBuilder{})
+
+		if builderType.NumMethod() != 2 {
+			t.Errorf("Expected only Finally and End, got %d methods", builderType.NumMethod())
+		}
+		if _, exists := builderType.MethodByName("Any"); exists {
+			t.Error("A second catch-all should not be possible")
+		}
+	})
+
+	t.Run("Typed handlers before Any take precedence", func(t *testing.T) {
+		var typed, any bool
+
+		cb := Try(func() {
+			ThrowArgumentNull("param", "typed")
+		}).When()
+		cb = On(cb, func(ex ArgumentNullException, full Exception) {
+			typed = true
+		})
+		result := cb.Any(func(ex Exception) {
+			any = true
+		}).End()
+
+		if !typed || any {
+			t.Errorf("Typed handler should win, got typed=%v any=%v", typed, any)
+		}
+		if result == nil || !result.HasException() {
+			t.Error("End should return the TryResult")
+		}
+	})
+
+	t.Run("Final stage on success path", func(t *testing.T) {
+		var any, cleaned bool
+
+		Try(func() {}).When().Any(func(ex Exception) {
+			any = true
+		}).Finally(func() {
+			cleaned = true
+		})
+
+		if any || !cleaned {
+			t.Errorf("Expected only cleanup on success, got any=%v cleaned=%v", any, cleaned)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestCancellationTranslation(t *testing.T) {
+	t.Run("Context errors never become InvalidOperationException", func(t *testing.T) {
+		canceled, ok := TranslateError(fmt.Errorf("query: %w", context.Canceled)).(OperationCanceledException)
+		if !ok || canceled.ByUser {
+			t.Errorf("Expected a system cancellation, got %v", canceled)
+		}
+		if _, ok := TranslateError(context.DeadlineExceeded).(TimeoutException); !ok {
+			t.Error("DeadlineExceeded should translate to TimeoutException")
+		}
+	})
+
+	t.Run("User cancellations are flagged", func(t *testing.T) {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(ErrCanceledByUser)
+
+		var byUser bool
+		Try(func() {
+			ThrowIfError(context.Cause(ctx))
+		}).Handle(Handler[OperationCanceledException](func(ex OperationCanceledException, full Exception) {
+			byUser = ex.ByUser
+		}))
+		if !byUser {
+			t.Error("Translated cause should be flagged ByUser")
+		}
+
+		ex := Try(func() { ReceiveCtx(ctx, make(chan int)) }).GetException()
+		if canceled, ok := ex.Type.(OperationCanceledException); !ok || !canceled.ByUser {
+			t.Errorf("ReceiveCtx should flag user cancellations, got %v", ex)
+		}
+	})
+
+	t.Run("Panicked context errors are cancellations", func(t *testing.T) {
+		ex := Try(func() { panic(context.Canceled) }).GetException()
+		if ex.TypeName() != "OperationCanceledException" {
+			t.Errorf("Expected OperationCanceledException, got %s", ex.TypeName())
+		}
+	})
+}
+
+func TestTryCtx(t *testing.T) {
+	t.Run("Observed cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(ErrCanceledByUser)
+
+		var processed int
+		var handlerCtx context.Context
+		TryCtx(ctx, func(ctx context.Context) {
+			for i := 0; i < 3; i++ {
+				ThrowIfDone(ctx)
+				processed++
+			}
+		}).Handle(
+			Handler[OperationCanceledException](func(ex OperationCanceledException, full Exception) {
+				if !ex.ByUser {
+					t.Error("Expected a cancellation by the user")
+				}
+				handlerCtx = full.Context()
+			}),
+		)
+
+		if processed != 0 || handlerCtx != ctx {
+			t.Errorf("Expected the block to stop and the handler to get the context, processed %d", processed)
+		}
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		time.Sleep(5 * time.Millisecond)
+
+		ex := TryCtx(ctx, func(ctx context.Context) {
+			ThrowIfError(ctx.Err())
+		}).GetException()
+		if ex == nil || ex.TypeName() != "TimeoutException" {
+			t.Errorf("Expected a TimeoutException, got %v", ex)
+		}
+	})
+
+	t.Run("Live context", func(t *testing.T) {
+		result := TryCtx(context.Background(), ThrowIfDone, WithName("noop"))
+		if result.HasException() || result.Name() != "noop" {
+			t.Error("A live context should not throw")
+		}
+	})
+}
+
+func TestTryWithTimeout(t *testing.T) {
+	t.Run("A block finishing in time behaves like Try", func(t *testing.T) {
+		if ex := TryWithTimeout(time.Second, func() {}).GetException(); ex != nil {
+			t.Errorf("Unexpected exception %v", ex)
+		}
+
+		ex := TryWithTimeout(time.Second, func() { ThrowInvalidOperation("bad state") }).GetException()
+		if _, ok := ex.Type.(InvalidOperationException); !ok {
+			t.Errorf("Expected the block's exception, got %v", ex)
+		}
+	})
+
+	t.Run("An overrunning block is abandoned", func(t *testing.T) {
+		release := make(chan struct{})
+		late := make(chan Event, 1)
+		observer := WithObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled {
+				if _, ok := event.Exception.Type.(InvalidOperationException); ok {
+					late <- event
+				}
+			}
+		}))
+
+		tr := TryWithTimeout(10*time.Millisecond, func() {
+			<-release
+			ThrowInvalidOperation("too late")
+		}, WithName("report"), observer)
+
+		timeout, ok := tr.GetException().Type.(TimeoutException)
+		if !ok || timeout.Operation != "report" || timeout.Timeout != 10*time.Millisecond || timeout.Elapsed < timeout.Timeout {
+			t.Fatalf("Expected a TimeoutException for report, got %v", tr.GetException())
+		}
+		tr.Any(func(Exception) {})
+
+		close(release)
+		select {
+		case <-late:
+		case <-time.After(time.Second):
+			t.Error("The late panic of the abandoned block should be reported")
+		}
+	})
+}
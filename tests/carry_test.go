@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestAttachToContext(t *testing.T) {
+	t.Run("Later stages receive the exception and rethrow it", func(t *testing.T) {
+		var unhandled int
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled {
+				unhandled++
+			}
+		}))
+		defer remove()
+
+		tr := Try(func() { ThrowArgumentNull("token", "missing bearer token") })
+		ctx := AttachToContext(context.Background(), tr)
+		tr.End()
+
+		if unhandled != 0 {
+			t.Error("An attached exception should not be reported as unhandled")
+		}
+		if report := tr.Report(); report.Outcome != OutcomeHandled || report.Handler != "AttachToContext" {
+			t.Errorf("Report = %v by %q, want handled by AttachToContext", report.Outcome, report.Handler)
+		}
+
+		ex := ExceptionFromContext(ctx)
+		if ex == nil {
+			t.Fatal("The attached exception should be found in the context")
+		}
+		origin := ex.Origin
+		rethrown := Try(func() { ex.Rethrow() }).GetException()
+		if _, ok := rethrown.Type.(ArgumentNullException); !ok || rethrown.Origin != origin {
+			t.Errorf("Rethrown exception = %v at %s, want ArgumentNullException at %s", rethrown, rethrown.Origin, origin)
+		}
+	})
+
+	t.Run("Parallel branches each get a copy", func(t *testing.T) {
+		ctx := AttachToContext(context.Background(), Try(func() { Throw(TimeoutException{Operation: "load"}) }))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ex := ExceptionFromContext(ctx)
+				if timeout, ok := ex.Type.(TimeoutException); !ok || timeout.Operation != "load" {
+					t.Errorf("Branch got %v", ex)
+				}
+				ex.Origin = "changed"
+			}()
+		}
+		wg.Wait()
+		if ExceptionFromContext(ctx).Origin == "changed" {
+			t.Error("Branches should not share the attached exception")
+		}
+	})
+
+	t.Run("Nothing is attached without an exception", func(t *testing.T) {
+		ctx := context.Background()
+		if AttachToContext(ctx, Try(func() {})) != ctx || AttachToContext(ctx, nil) != ctx {
+			t.Error("The context should be returned as is")
+		}
+		if ExceptionFromContext(ctx) != nil {
+			t.Error("No exception was attached")
+		}
+	})
+
+	t.Run("Handled exceptions keep their handler", func(t *testing.T) {
+		tr := Try(func() { Throw(TimeoutException{Operation: "load"}) }).Any(func(Exception) {})
+		ctx := AttachToContext(context.Background(), tr)
+		if ExceptionFromContext(ctx) == nil || tr.Report().Handler != "Any" {
+			t.Errorf("Handler = %q, want Any with the exception attached", tr.Report().Handler)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// chainOutcome is what a chain did, observed from outside
+type chainOutcome struct {
+	escaped   string // TypeName of the exception thrown out of the chain, "" if none
+	unhandled int    // EventUnhandled events
+	handled   int    // EventHandled events
+	cleaned   bool   // the cleanup of Finally or FinallyWithin ran
+	err       bool   // AsError returned an error
+	outcome   Outcome
+}
+
+// chainStates start a chain in each state a terminal method can see
+var chainStates = map[string]func(opts ...TryOption) *TryResult{
+	"success": func(opts ...TryOption) *TryResult {
+		return Try(func() {}, opts...)
+	},
+	"handled": func(opts ...TryOption) *TryResult {
+		return Try(func() { ThrowInvalidOperation("handled") }, opts...).Any(func(Exception) {})
+	},
+	"unhandled": func(opts ...TryOption) *TryResult {
+		return Try(func() { ThrowInvalidOperation("unhandled") }, opts...).Handle(
+			Handler[NetworkException](func(ex NetworkException, full Exception) {}),
+		)
+	},
+}
+
+// chainTerminals end a chain, setting what the outcome cannot observe by itself
+var chainTerminals = map[string]func(tr *TryResult, out *chainOutcome){
+	"Finally":       func(tr *TryResult, out *chainOutcome) { tr.Finally(func() { out.cleaned = true }) },
+	"FinallyWithin": func(tr *TryResult, out *chainOutcome) { tr.FinallyWithin(time.Second, func() { out.cleaned = true }) },
+	"End":           func(tr *TryResult, out *chainOutcome) { tr.End() },
+	"Rethrow":       func(tr *TryResult, out *chainOutcome) { tr.Rethrow() },
+	"RethrowAs": func(tr *TryResult, out *chainOutcome) {
+		tr.RethrowAs(LifecycleException{Stage: "stop", Phase: "chain"})
+	},
+	"AsError": func(tr *TryResult, out *chainOutcome) { out.err = tr.AsError() != nil },
+	"End twice": func(tr *TryResult, out *chainOutcome) {
+		tr.End()
+		tr.End()
+	},
+	"Finally then Rethrow": func(tr *TryResult, out *chainOutcome) {
+		tr.Finally(func() { out.cleaned = true })
+		tr.Rethrow()
+	},
+}
+
+func runChain(state, terminal string) chainOutcome {
+	var out chainOutcome
+	observer := WithObserver(ObserverFunc(func(event Event) {
+		switch event.Kind {
+		case EventUnhandled:
+			out.unhandled++
+		case EventHandled:
+			out.handled++
+		}
+	}))
+
+	var tr *TryResult
+	escaped := Try(func() {
+		tr = chainStates[state](observer)
+		chainTerminals[terminal](tr, &out)
+	}).GetException()
+	if escaped != nil {
+		out.escaped = escaped.TypeName()
+	}
+	out.outcome = tr.Report().Outcome
+	return out
+}
+
+func TestChainStateMachine(t *testing.T) {
+	cleans := map[string]bool{"Finally": true, "FinallyWithin": true, "Finally then Rethrow": true}
+
+	for state := range chainStates {
+		for terminal := range chainTerminals {
+			t.Run(state+"/"+terminal, func(t *testing.T) {
+				got := runChain(state, terminal)
+
+				want := chainOutcome{cleaned: cleans[terminal], outcome: OutcomeSucceeded}
+				switch state {
+				case "handled":
+					want.handled, want.outcome = 1, OutcomeHandled
+				case "unhandled":
+					want.unhandled, want.outcome = 1, OutcomeUnhandled
+					switch terminal {
+					case "Rethrow", "Finally then Rethrow":
+						want.escaped = "InvalidOperationException"
+					case "RethrowAs":
+						want.escaped = "LifecycleException"
+					case "AsError":
+						want.err = true
+					}
+				}
+
+				if got != want {
+					t.Errorf("got %+v, want %+v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestChainSuccessPath(t *testing.T) {
+	var calls []string
+	record := func(name string) func() {
+		return func() { calls = append(calls, name) }
+	}
+
+	tr := Try(record("block"))
+	same := tr.Handle(HandlerAny(func(Exception) { calls = append(calls, "handler") })).
+		HandleWithin(time.Second, HandlerAny(func(Exception) { calls = append(calls, "handler") })).
+		Any(func(Exception) { calls = append(calls, "handler") }).
+		Else(record("else")).
+		Defer(record("defer"))
+	Catch(same, func(ex InvalidOperationException, full Exception) { calls = append(calls, "handler") })
+	On(same.When(), func(ex InvalidOperationException, full Exception) { calls = append(calls, "handler") })
+	same.Finally(record("finally"))
+
+	if same != tr {
+		t.Error("Chain methods should return the same result")
+	}
+	if got := len(calls); got != 4 || calls[0] != "block" || calls[1] != "else" || calls[2] != "finally" || calls[3] != "defer" {
+		t.Errorf("Unexpected calls %v", calls)
+	}
+	if tr.HasException() || tr.GetException() != nil || tr.Err() != nil || tr.HandlerFailed() || tr.Report().Outcome != OutcomeSucceeded {
+		t.Error("A successful chain should report no exception")
+	}
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := NewCircuitBreaker("payments",
+		TripOn[NetworkException](),
+		WithTripThreshold(2, time.Minute),
+		WithCooldown(20*time.Millisecond),
+	)
+	fail := func() { ThrowNetworkError("https://pay", "unreachable", nil) }
+
+	t.Run("Other exception types never trip", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			breaker.Try(func() { ThrowArgumentNull("amount", "required") }).Any(func(Exception) {})
+		}
+		if state := breaker.State(); state != CircuitClosed {
+			t.Errorf("Expected closed, got %v", state)
+		}
+	})
+
+	t.Run("Matching exceptions open the circuit", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			ex := breaker.Try(fail).GetException()
+			if _, ok := ex.Type.(NetworkException); !ok {
+				t.Fatalf("The block's exception should propagate, got %v", ex)
+			}
+		}
+		if state := breaker.State(); state != CircuitOpen {
+			t.Fatalf("Expected open, got %v", state)
+		}
+
+		ran := false
+		ex := breaker.Try(func() { ran = true }).GetException()
+		open, ok := ex.Type.(CircuitOpenException)
+		if ran || !ok || open.Circuit != "payments" || open.RetryAfter <= 0 {
+			t.Errorf("Expected a CircuitOpenException without running the block, got %v", ex)
+		}
+		if status := HTTPStatusFor(ex); status != http.StatusServiceUnavailable {
+			t.Errorf("Expected 503, got %d", status)
+		}
+	})
+
+	t.Run("A failed trial reopens the circuit", func(t *testing.T) {
+		time.Sleep(25 * time.Millisecond)
+		if state := breaker.State(); state != CircuitHalfOpen {
+			t.Fatalf("Expected half-open after the cooldown, got %v", state)
+		}
+		breaker.Try(fail).Any(func(Exception) {})
+		if state := breaker.State(); state != CircuitOpen {
+			t.Errorf("Expected open after a failed trial, got %v", state)
+		}
+	})
+
+	t.Run("A successful trial closes the circuit", func(t *testing.T) {
+		time.Sleep(25 * time.Millisecond)
+		if ex := breaker.Try(func() {}).GetException(); ex != nil {
+			t.Fatalf("The trial should run, got %v", ex)
+		}
+		if state := breaker.State(); state != CircuitClosed {
+			t.Errorf("Expected closed after a successful trial, got %v", state)
+		}
+	})
+}
+
+func TestCircuitBreakerDefaults(t *testing.T) {
+	breaker := NewCircuitBreaker("inventory", WithTripThreshold(1, time.Minute))
+
+	breaker.Try(func() { ThrowInvalidOperation("bad state") }).Any(func(Exception) {})
+	if state := breaker.State(); state != CircuitClosed {
+		t.Errorf("Non-retryable exceptions should not trip by default, got %v", state)
+	}
+	breaker.Try(func() { ThrowNetworkError("https://inventory", "reset", nil) }).Any(func(Exception) {})
+	if state := breaker.State(); state != CircuitOpen {
+		t.Errorf("Retryable exceptions should trip by default, got %v", state)
+	}
+}
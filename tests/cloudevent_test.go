@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestToCloudEvent(t *testing.T) {
+	RedactKeys("password")
+	defer ClearRedactionRules()
+
+	ex := Try(func() {
+		ThrowWithInner(
+			PaymentDeclinedException{SimpleException: SimpleException{Message: "declined", Code: "card_declined"}},
+			&Exception{Type: NetworkException{URL: "https://psp", Message: "reset"}},
+		)
+	}, WithName("checkout")).GetException()
+	ex.Data["password"] = "hunter2"
+
+	event, err := ToCloudEvent(ex, "/services/checkout")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if event.SpecVersion != "1.0" || event.Type != "goexceptions.card_declined" || event.Source != "/services/checkout" ||
+		event.Subject != "checkout" || event.ID == "" || event.Time.IsZero() {
+		t.Errorf("Unexpected attributes %+v", event)
+	}
+
+	var data CloudEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		t.Fatalf("Data should be JSON: %v", err)
+	}
+	if data.Type != "PaymentDeclinedException" || data.Code != "card_declined" || data.Inner == nil || data.Inner.Type != "NetworkException" {
+		t.Errorf("Unexpected data %+v", data)
+	}
+	if data.Data["password"] == "hunter2" {
+		t.Error("Data should be redacted")
+	}
+
+	other, _ := ToCloudEvent(&Exception{Type: InvalidOperationException{Message: "x"}}, "/jobs")
+	if other.Type != "goexceptions.InvalidOperationException" || other.ID == event.ID {
+		t.Errorf("Unexpected event %+v", other)
+	}
+
+	encoded, _ := json.Marshal(event)
+	var fields map[string]interface{}
+	json.Unmarshal(encoded, &fields)
+	if fields["specversion"] != "1.0" || fields["datacontenttype"] != "application/json" {
+		t.Errorf("Unexpected structured encoding %s", encoded)
+	}
+}
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type billingNotFound struct{}
+
+func (billingNotFound) Error() string    { return "NotFoundException: invoice" }
+func (billingNotFound) TypeName() string { return "CollidingNotFoundException" }
+
+type catalogNotFound struct{}
+
+func (catalogNotFound) Error() string    { return "NotFoundException: product" }
+func (catalogNotFound) TypeName() string { return "CollidingNotFoundException" }
+
+type debugCollisionA struct{}
+
+func (debugCollisionA) Error() string    { return "debug A" }
+func (debugCollisionA) TypeName() string { return "DebugCollisionException" }
+
+type debugCollisionB struct{}
+
+func (debugCollisionB) Error() string    { return "debug B" }
+func (debugCollisionB) TypeName() string { return "DebugCollisionException" }
+
+type slowHandlerAlert struct{}
+
+func (slowHandlerAlert) Error() string    { return "slow handler" }
+func (slowHandlerAlert) TypeName() string { return "SlowHandlerException" }
+
+func TestTypeNameCollision(t *testing.T) {
+	t.Run("Reported to observers at registration and first use", func(t *testing.T) {
+		var reported []TypeNameCollisionException
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if collision, ok := event.Exception.Type.(TypeNameCollisionException); ok {
+				reported = append(reported, collision)
+			}
+		}))
+		defer remove()
+
+		RegisterPolicy[billingNotFound](ExceptionPolicy{})
+		defer UnregisterPolicy[billingNotFound]()
+
+		Try(func() { Throw(catalogNotFound{}) }).End()
+		Try(func() { Throw(catalogNotFound{}) }).End()
+
+		if len(reported) != 1 {
+			t.Fatalf("Expected the collision to be reported once, got %d", len(reported))
+		}
+		collision := reported[0]
+		if collision.Name != "CollidingNotFoundException" ||
+			collision.Existing != "github.com/bencz/go-exceptions/tests.billingNotFound" ||
+			collision.Conflicting != "github.com/bencz/go-exceptions/tests.catalogNotFound" {
+			t.Errorf("Unexpected collision: %+v", collision)
+		}
+
+		found := false
+		for _, c := range TypeNameCollisions() {
+			found = found || c == collision
+		}
+		if !found {
+			t.Error("Collision should be listed by TypeNameCollisions")
+		}
+	})
+
+	t.Run("Built-in names collide before they are caught", func(t *testing.T) {
+		Try(func() { Throw(slowHandlerAlert{}) }).End()
+
+		found := false
+		for _, c := range TypeNameCollisions() {
+			found = found || c.Name == "SlowHandlerException" && c.Existing == "github.com/bencz/go-exceptions.SlowHandlerException"
+		}
+		if !found {
+			t.Errorf("Expected a collision with the built-in type, got %+v", TypeNameCollisions())
+		}
+	})
+
+	t.Run("Thrown in debug mode", func(t *testing.T) {
+		SetDebugMode(true)
+		defer SetDebugMode(false)
+
+		Preload[debugCollisionA]()
+		tr := Try(func() {
+			Preload[debugCollisionB]()
+		})
+		if !tr.HasException() || tr.GetException().TypeName() != "TypeNameCollisionException" {
+			t.Errorf("Expected a TypeNameCollisionException, got %v", tr.GetException())
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// pgError mimics the SQLSTATE-reporting errors of pgx and lib/pq
+type pgError struct {
+	code string
+}
+
+func (e *pgError) Error() string    { return "pq: could not serialize access (SQLSTATE " + e.code + ")" }
+func (e *pgError) SQLState() string { return e.code }
+
+func TestConcurrencyException(t *testing.T) {
+	t.Run("Helper and default classification", func(t *testing.T) {
+		ex := Try(func() {
+			ThrowConcurrencyConflict("order/17", 3, 4)
+		}).GetException()
+
+		conflict, ok := ex.Type.(ConcurrencyException)
+		if !ok || conflict.Entity != "order/17" || conflict.ExpectedVersion != 3 || conflict.ActualVersion != 4 {
+			t.Fatalf("Unexpected exception: %v", ex)
+		}
+		if ex.Error() != "ConcurrencyException: conflicting update of 'order/17' (expected version 3, found 4). " {
+			t.Errorf("Unexpected message: %q", ex.Error())
+		}
+		if policy := PolicyFor(ex); !policy.Retryable || !policy.Reload {
+			t.Errorf("Expected retryable with reload, got %+v", policy)
+		}
+		if HTTPStatusFor(ex) != http.StatusConflict {
+			t.Errorf("Expected 409, got %d", HTTPStatusFor(ex))
+		}
+	})
+
+	t.Run("SQL serialization failures are translated", func(t *testing.T) {
+		for _, code := range []string{"40001", "40P01"} {
+			err := fmt.Errorf("commit: %w", &pgError{code: code})
+			if _, ok := TranslateError(err).(ConcurrencyException); !ok {
+				t.Errorf("SQLSTATE %s should translate to ConcurrencyException", code)
+			}
+		}
+		if _, ok := TranslateError(&pgError{code: "23505"}).(ConcurrencyException); ok {
+			t.Error("Unique violations are not concurrency conflicts")
+		}
+	})
+
+	t.Run("ConflictTranslator maps ORM sentinels", func(t *testing.T) {
+		errStale := errors.New("stale object")
+		remove := RegisterTranslator(ConflictTranslator(errStale))
+		defer remove()
+
+		var retried bool
+		Try(func() {
+			ThrowIfError(fmt.Errorf("save invoice: %w", errStale))
+		}).Handle(Handler[ConcurrencyException](func(ex ConcurrencyException, full Exception) {
+			retried = IsRetryable(&full)
+		}))
+		if !retried {
+			t.Error("Expected a retryable ConcurrencyException")
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func restoreSubsystems(t *testing.T) {
+	saved := Subsystems()
+	t.Cleanup(func() {
+		for subsystem, enabled := range saved {
+			SetEnabled(subsystem, enabled)
+		}
+	})
+}
+
+func TestSubsystemToggles(t *testing.T) {
+	restoreSubsystems(t)
+
+	t.Run("Defaults", func(t *testing.T) {
+		states := Subsystems()
+		for _, subsystem := range []Subsystem{SubsystemStacks, SubsystemHistory, SubsystemObservers, SubsystemSampling} {
+			if !states[subsystem] {
+				t.Errorf("Expected %s to be on by default", subsystem)
+			}
+		}
+		if states[SubsystemDebug] != DebugMode() {
+			t.Error("Debug subsystem should follow debug mode")
+		}
+	})
+
+	t.Run("Stacks off keeps the origin", func(t *testing.T) {
+		SetEnabled(SubsystemStacks, false)
+		defer SetEnabled(SubsystemStacks, true)
+
+		ex := Try(func() { ThrowInvalidOperation("no stack") }).Any(func(Exception) {}).GetException()
+		if len(ex.StackTrace) != 0 || ex.Origin == "" {
+			t.Errorf("Expected only an origin, got %d frames and %q", len(ex.StackTrace), ex.Origin)
+		}
+	})
+
+	t.Run("Observers off", func(t *testing.T) {
+		var events int
+		remove := AddObserver(ObserverFunc(func(Event) { events++ }))
+		defer remove()
+
+		SetEnabled(SubsystemObservers, false)
+		Try(func() { ThrowInvalidOperation("silent") }).Any(func(Exception) {})
+		SetEnabled(SubsystemObservers, true)
+		if events != 0 {
+			t.Errorf("Expected no events while off, got %d", events)
+		}
+
+		Try(func() { ThrowInvalidOperation("heard") }).Any(func(Exception) {})
+		if events == 0 {
+			t.Error("Expected events once back on")
+		}
+	})
+
+	t.Run("History off", func(t *testing.T) {
+		ClearHistory()
+		SetEnabled(SubsystemHistory, false)
+		Try(func() { ThrowInvalidOperation("forgotten") }).Any(func(Exception) {})
+		SetEnabled(SubsystemHistory, true)
+
+		var entries int
+		RangeHistory(func(HistoryEntry) bool { entries++; return true })
+		if entries != 0 {
+			t.Errorf("Expected no history while off, got %d", entries)
+		}
+	})
+
+	t.Run("Unknown subsystem", func(t *testing.T) {
+		if SetEnabled("tracing", true) == nil || Enabled("tracing") {
+			t.Error("Expected unknown subsystems to be rejected")
+		}
+	})
+}
+
+func TestConfigure(t *testing.T) {
+	restoreSubsystems(t)
+
+	if err := Configure("debug=on, Sampling=off,history=0"); err != nil {
+		t.Fatal(err)
+	}
+	if !DebugMode() || Enabled(SubsystemSampling) || Enabled(SubsystemHistory) {
+		t.Errorf("Settings not applied: %v", Subsystems())
+	}
+
+	for _, spec := range []string{"stacks", "stacks=maybe", "tracing=on,history=on"} {
+		if Configure(spec) == nil {
+			t.Errorf("Expected %q to be rejected", spec)
+		}
+	}
+	if Enabled(SubsystemHistory) {
+		t.Error("An invalid spec should apply nothing")
+	}
+
+	t.Setenv(EnvConfig, "debug=off,history=on")
+	if err := ConfigureFromEnv(); err != nil {
+		t.Fatal(err)
+	}
+	if DebugMode() || !Enabled(SubsystemHistory) {
+		t.Errorf("Environment not applied: %v", Subsystems())
+	}
+}
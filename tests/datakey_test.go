@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestTypedDataKeys(t *testing.T) {
+	attemptKey := DataKey[int]{Name: "attempt"}
+	deadlineKey := DataKey[time.Time]{Name: "deadline"}
+
+	t.Run("Typed values round-trip", func(t *testing.T) {
+		ex := Try(func() { ThrowInvalidOperation("boom") }).GetException()
+		deadline := time.Now()
+		SetTyped(ex, RequestIDKey, "req-42")
+		SetTyped(ex, attemptKey, 3)
+		SetTyped(ex, deadlineKey, deadline)
+
+		if id, ok := GetTyped(ex, RequestIDKey); !ok || id != "req-42" {
+			t.Errorf("Unexpected request ID: %q, %v", id, ok)
+		}
+		if attempt, ok := GetTyped(ex, attemptKey); !ok || attempt != 3 {
+			t.Errorf("Unexpected attempt: %d, %v", attempt, ok)
+		}
+		if got, _ := GetTyped(ex, deadlineKey); !got.Equal(deadline) {
+			t.Errorf("Unexpected deadline: %v", got)
+		}
+		if ex.Data["request_id"] != "req-42" {
+			t.Error("Typed values should be visible in the Data map")
+		}
+	})
+
+	t.Run("Missing or mistyped entries report false", func(t *testing.T) {
+		ex := &Exception{}
+		if _, ok := GetTyped(ex, UserIDKey); ok {
+			t.Error("Missing entry should report false")
+		}
+
+		SetTyped(ex, TenantKey, "acme")
+		ex.Data["attempt"] = "three"
+		if attempt, ok := GetTyped(ex, attemptKey); ok || attempt != 0 {
+			t.Errorf("Mistyped entry should report false, got %d", attempt)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestDefer(t *testing.T) {
+	t.Run("Cleanups run in LIFO order after Finally", func(t *testing.T) {
+		var order []string
+		Try(func() {
+			ThrowInvalidOperation("boom")
+		}).
+			Defer(func() { order = append(order, "close file") }).
+			Defer(func() { order = append(order, "release lock") }).
+			Any(func(ex Exception) { order = append(order, "handle") }).
+			Finally(func() { order = append(order, "finally") })
+
+		expected := []string{"handle", "finally", "release lock", "close file"}
+		if !reflect.DeepEqual(order, expected) {
+			t.Errorf("Expected %v, got %v", expected, order)
+		}
+	})
+
+	t.Run("Panicking cleanups are captured independently", func(t *testing.T) {
+		var events []Event
+		observer := ObserverFunc(func(event Event) { events = append(events, event) })
+
+		ran := false
+		tr := Try(func() {}, WithObserver(observer)).
+			Defer(func() { ran = true }).
+			Defer(func() { ThrowFileError("tmp.db", "remove failed", nil) }).
+			Defer(func() { panic("flush failed") }).
+			End()
+
+		if !ran {
+			t.Error("First cleanup should still run")
+		}
+		failures := tr.DeferFailures()
+		if len(failures) != 2 || failures[0].TypeName() != "InvalidOperationException" || failures[1].TypeName() != "FileException" {
+			t.Fatalf("Unexpected failures: %v", failures)
+		}
+		if len(events) != 2 || events[0].Kind != EventUnhandled {
+			t.Errorf("Expected failures reported as unhandled, got %v", events)
+		}
+	})
+
+	t.Run("Rethrow runs cleanups before propagating", func(t *testing.T) {
+		cleaned := false
+		ex := Try(func() {
+			Try(func() {
+				ThrowArgumentNull("id", "missing")
+			}).Defer(func() { cleaned = true }).Rethrow()
+		}).GetException()
+
+		if !cleaned || ex == nil {
+			t.Errorf("Expected cleanup and rethrow, got cleaned=%v ex=%v", cleaned, ex)
+		}
+
+		cleaned = false
+		Try(func() {}).Defer(func() { cleaned = true }).Rethrow()
+		if !cleaned {
+			t.Error("Rethrow without exception should still end the chain")
+		}
+	})
+
+	t.Run("Cleanups registered after the chain ended run immediately", func(t *testing.T) {
+		tr := Try(func() {}).End()
+		ran := false
+		tr.Defer(func() { ran = true })
+		if !ran {
+			t.Error("Late cleanup should run immediately")
+		}
+	})
+}
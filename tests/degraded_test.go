@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestDegradedOutcome(t *testing.T) {
+	t.Run("Handler marks the outcome as degraded", func(t *testing.T) {
+		tr := Try(func() {
+			ThrowNetworkError("https://recommendations", "timeout", nil)
+		}).Handle(
+			Handler[NetworkException](func(ex NetworkException, full Exception) {
+				full.Degraded("served default recommendations")
+			}),
+		)
+
+		if !tr.IsDegraded() {
+			t.Fatal("Outcome should be degraded")
+		}
+		if tr.DegradedReason() != "served default recommendations" {
+			t.Errorf("Unexpected reason: %s", tr.DegradedReason())
+		}
+	})
+
+	t.Run("Fully recovered outcome is not degraded", func(t *testing.T) {
+		tr := Try(func() {
+			ThrowNetworkError("https://recommendations", "timeout", nil)
+		}).Any(func(ex Exception) {})
+
+		if tr.IsDegraded() || tr.DegradedReason() != "" {
+			t.Error("Outcome should not be degraded")
+		}
+	})
+
+	t.Run("Degraded is emitted to observers", func(t *testing.T) {
+		var reason string
+		Try(func() {
+			ThrowInvalidOperation("partial")
+		}, WithObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventDegraded {
+				reason = event.Reason
+			}
+		}))).Any(func(ex Exception) {
+			ex.Degraded("partial results")
+		})
+
+		if reason != "partial results" {
+			t.Errorf("Expected degraded event with reason, got '%s'", reason)
+		}
+	})
+
+	t.Run("Degraded on the result directly", func(t *testing.T) {
+		tr := Try(func() {}).Degraded("")
+		if !tr.IsDegraded() {
+			t.Error("Result should be degraded")
+		}
+	})
+
+	t.Run("Exception outside of Try ignores Degraded", func(t *testing.T) {
+		ex := Exception{Type: InvalidOperationException{Message: "standalone"}}
+		ex.Degraded("nothing to mark")
+	})
+}
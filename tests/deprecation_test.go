@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type LegacyQuotaException struct{ SimpleException }
+
+type QuotaLimitException struct{ SimpleException }
+
+func TestDeprecate(t *testing.T) {
+	var warnings []DeprecatedThrowException
+	remove := AddObserver(ObserverFunc(func(event Event) {
+		if event.Kind == EventWarning {
+			if warning, ok := event.Exception.Type.(DeprecatedThrowException); ok {
+				warnings = append(warnings, warning)
+			}
+		}
+	}))
+	defer remove()
+
+	Deprecate[LegacyQuotaException](QuotaLimitException{})
+	defer Undeprecate[LegacyQuotaException]()
+
+	throwLegacy := func() { Throw(LegacyQuotaException{}) }
+	for i := 0; i < 3; i++ {
+		Try(throwLegacy).Any(func(Exception) {})
+	}
+	Try(func() { Throw(LegacyQuotaException{}) }).Any(func(Exception) {})
+	Try(func() { Throw(QuotaLimitException{}) }).Any(func(Exception) {})
+
+	if len(warnings) != 2 {
+		t.Fatalf("Expected one warning per throw site, got %d: %v", len(warnings), warnings)
+	}
+	warning := warnings[0]
+	if warning.Deprecated != "LegacyQuotaException" || len(warning.Replacements) != 1 || warning.Replacements[0] != "QuotaLimitException" || warning.Origin == "" {
+		t.Errorf("Unexpected warning %+v", warning)
+	}
+
+	Undeprecate[LegacyQuotaException]()
+	Try(throwLegacy).Any(func(Exception) {})
+	if len(warnings) != 2 {
+		t.Errorf("Undeprecated types should not warn, got %v", warnings)
+	}
+}
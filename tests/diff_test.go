@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestDiffExceptions(t *testing.T) {
+	capture := func(block func()) *Exception {
+		return Try(block).GetException()
+	}
+
+	t.Run("Identical contracts produce an empty report", func(t *testing.T) {
+		old := capture(func() { ThrowNetworkError("https://api", "timeout", errors.New("dial")) })
+		new := capture(func() { ThrowNetworkError("https://other", "refused", nil) })
+
+		report := DiffExceptions(old, new)
+		if !report.Empty() {
+			t.Errorf("Expected no changes, got %s", report)
+		}
+		if report.String() != "no changes" {
+			t.Errorf("Unexpected String: %s", report)
+		}
+	})
+
+	t.Run("Type, code and data key changes are reported", func(t *testing.T) {
+		old := capture(func() {
+			Throw(PaymentDeclinedException{SimpleException: SimpleException{Message: "declined", Code: "card_declined"}})
+		})
+		old.Data["order"] = "A-17"
+
+		new := capture(func() {
+			Throw(PaymentDeclinedException{SimpleException: SimpleException{Message: "declined", Code: "insufficient_funds"}})
+		})
+		new.Data["order"] = "A-17"
+		new.Data["retry"] = true
+
+		report := DiffExceptions(old, new)
+		if len(report.Changes) != 2 {
+			t.Fatalf("Expected 2 changes, got %s", report)
+		}
+		code, keys := report.Changes[0], report.Changes[1]
+		if code.Kind != DiffCode || code.Old != "card_declined" || code.New != "insufficient_funds" {
+			t.Errorf("Unexpected code change: %+v", code)
+		}
+		if keys.Kind != DiffDataKeys || keys.Old != "order" || keys.New != "order,retry" {
+			t.Errorf("Unexpected data key change: %+v", keys)
+		}
+
+		typeChange := DiffExceptions(old, capture(func() { ThrowInvalidOperation("declined") })).Changes[0]
+		if typeChange.Kind != DiffType || typeChange.New != "InvalidOperationException" {
+			t.Errorf("Unexpected type change: %+v", typeChange)
+		}
+	})
+
+	t.Run("Chain shape and inner changes are reported with their depth", func(t *testing.T) {
+		inner := capture(func() { ThrowArgumentNull("id", "missing") })
+		old := capture(func() { ThrowWithInner(InvalidOperationException{Message: "load"}, inner) })
+		new := capture(func() {
+			ThrowWithInner(InvalidOperationException{Message: "load"}, capture(func() {
+				ThrowWithInner(FileException{Filename: "a", Message: "read"}, inner)
+			}))
+		})
+
+		report := DiffExceptions(old, new)
+		if report.Changes[0].Kind != DiffChain {
+			t.Fatalf("Expected a chain change first, got %s", report)
+		}
+		if report.Changes[0].New != "InvalidOperationException > FileException > ArgumentNullException" {
+			t.Errorf("Unexpected chain shape: %s", report.Changes[0].New)
+		}
+		if report.Changes[1].Kind != DiffType || report.Changes[1].Depth != 1 {
+			t.Errorf("Expected a type change at depth 1, got %+v", report.Changes[1])
+		}
+		if !strings.Contains(report.String(), "chain at depth 0") {
+			t.Errorf("Unexpected String: %s", report)
+		}
+	})
+
+	t.Run("Nil exceptions are empty chains", func(t *testing.T) {
+		if !DiffExceptions(nil, nil).Empty() {
+			t.Error("Two nil exceptions should not differ")
+		}
+		report := DiffExceptions(nil, capture(func() { ThrowInvalidOperation("new failure") }))
+		if len(report.Changes) != 1 || report.Changes[0].Kind != DiffChain || report.Changes[0].Old != "" {
+			t.Errorf("Expected one chain change, got %s", report)
+		}
+	})
+}
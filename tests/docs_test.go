@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestRegisterDoc(t *testing.T) {
+	RegisterDoc[TimeoutException]("a downstream call missed its deadline", "check downstream latency")
+	defer UnregisterDoc[TimeoutException]()
+	RegisterDoc[PaymentException]("the payment provider refused the charge", "")
+	defer UnregisterDoc[PaymentException]()
+
+	t.Run("Reports carry the doc", func(t *testing.T) {
+		report := Try(func() { Throw(TimeoutException{Operation: "quote"}) }).Any(func(Exception) {}).Report()
+		if report.Exception.Remediation != "check downstream latency" ||
+			report.Exception.Description != "a downstream call missed its deadline" {
+			t.Errorf("Unexpected summary: %+v", report.Exception)
+		}
+	})
+
+	t.Run("Children inherit the doc of their parent", func(t *testing.T) {
+		ex := Try(throwDeclined).Any(func(Exception) {}).GetException()
+		doc, ok := DocFor(ex)
+		if !ok || doc.TypeName != "PaymentException" {
+			t.Errorf("Expected the parent doc, got %+v", doc)
+		}
+		if _, ok := DocFor(&Exception{Type: InvalidOperationException{}}); ok {
+			t.Error("Undocumented types should have no doc")
+		}
+	})
+
+	t.Run("Generated docs", func(t *testing.T) {
+		var out strings.Builder
+		if err := WriteDocs(&out); err != nil {
+			t.Fatal(err)
+		}
+		expected := "## PaymentException\n\nthe payment provider refused the charge\n\n" +
+			"## TimeoutException\n\na downstream call missed its deadline\n\n**Remediation:** check downstream latency\n\n"
+		if out.String() != expected {
+			t.Errorf("Unexpected docs:\n%s", out.String())
+		}
+	})
+}
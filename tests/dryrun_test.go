@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	SetDryRunLogger(slog.New(slog.NewTextHandler(&buf, nil)))
+	defer SetDryRunLogger(nil)
+
+	t.Run("Policy is logged but not enforced", func(t *testing.T) {
+		buf.Reset()
+		remove := RegisterPolicyDryRun[InvalidOperationException](ExceptionPolicy{SLO: SLOCorrectness, Retryable: true})
+		defer remove()
+
+		tr := Try(func() {
+			ThrowInvalidOperation("state")
+		}, WithName("dry-policy"))
+		tr.End()
+
+		if tr.Policy().Retryable {
+			t.Error("Dry-run policy must not change behavior")
+		}
+		out := buf.String()
+		for _, want := range []string{"dry-run policy", "changed=true", "proposed.slo=correctness", "proposed.retryable=true", "operation=dry-policy"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("Expected %q in %q", want, out)
+			}
+		}
+	})
+
+	t.Run("Translator is logged but not used", func(t *testing.T) {
+		buf.Reset()
+		errTimeout := errors.New("upstream timeout")
+		remove := RegisterTranslatorDryRun(func(err error) (ExceptionType, bool) {
+			if errors.Is(err, errTimeout) {
+				return NetworkException{Message: err.Error()}, true
+			}
+			return nil, false
+		})
+		defer remove()
+
+		translated := TranslateError(errTimeout)
+		if translated.TypeName() != "InvalidOperationException" {
+			t.Errorf("Dry-run translator must not change the mapping, got %s", translated.TypeName())
+		}
+		out := buf.String()
+		if !strings.Contains(out, "current.type=InvalidOperationException") || !strings.Contains(out, "proposed.type=NetworkException") {
+			t.Errorf("Expected both mappings to be logged, got %q", out)
+		}
+
+		buf.Reset()
+		TranslateError(errors.New("unrelated"))
+		if buf.Len() != 0 {
+			t.Errorf("Unrecognized errors should not be logged, got %q", buf.String())
+		}
+	})
+
+	t.Run("Removed dry runs stop logging", func(t *testing.T) {
+		buf.Reset()
+		remove := RegisterPolicyDryRun[FileException](ExceptionPolicy{Retryable: true})
+		remove()
+		Try(func() { ThrowFileError("f", "x", nil) }).End()
+		if buf.Len() != 0 {
+			t.Errorf("Expected no logs, got %q", buf.String())
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestEnricher(t *testing.T) {
+	withRequest := Enricher(func(ex *Exception) {
+		ex.Data["request_id"] = "req-7"
+	})
+
+	t.Run("Runs first and leaves the exception to the matching handler", func(t *testing.T) {
+		var seen interface{}
+		tr := Try(func() { ThrowInvalidOperation("ledger closed") }).Handle(
+			Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+				seen = full.Data["request_id"]
+			}),
+			withRequest,
+		)
+		if seen != "req-7" {
+			t.Errorf("Handler saw request_id %v, want the enriched value", seen)
+		}
+		if report := tr.Report(); report.Handler != "Handler[InvalidOperationException]" || report.Exception.Data["request_id"] != "req-7" {
+			t.Errorf("Report handler %q, data %v", report.Handler, report.Exception.Data)
+		}
+	})
+
+	t.Run("Does not consume the exception", func(t *testing.T) {
+		var unhandled *Exception
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled {
+				unhandled = event.Exception
+			}
+		}))
+		defer remove()
+
+		tr := Try(func() { ThrowInvalidOperation("ledger closed") }).Handle(withRequest)
+		tr.End()
+		if tr.Report().Outcome != OutcomeUnhandled {
+			t.Error("An enricher alone should leave the exception unhandled")
+		}
+		if unhandled == nil || unhandled.Data["request_id"] != "req-7" {
+			t.Errorf("Observers should see the enriched exception, got %v", unhandled)
+		}
+	})
+
+	t.Run("Panics are reported and handling goes on", func(t *testing.T) {
+		var failed bool
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			failed = failed || event.Kind == EventHandlerFailed
+		}))
+		defer remove()
+
+		var handled bool
+		tr := Try(func() { ThrowInvalidOperation("ledger closed") }).HandleWithin(time.Second,
+			Enricher(func(ex *Exception) { panic("enricher bug") }),
+			HandlerAny(func(Exception) { handled = true }),
+		)
+		if !failed || !handled || tr.HandlerFailed() {
+			t.Errorf("failure reported %v, handled %v, handler failed %v", failed, handled, tr.HandlerFailed())
+		}
+	})
+}
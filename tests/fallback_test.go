@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestFallbacks(t *testing.T) {
+	t.Run("Primary result is used when it succeeds", func(t *testing.T) {
+		result := Fallbacks(
+			func() string { return "primary" },
+			func() string { return "replica" },
+		)
+
+		if result.Value != "primary" || !result.FromPrimary() {
+			t.Errorf("Expected primary result, got %+v", result)
+		}
+	})
+
+	t.Run("Retryable failures move to the next source", func(t *testing.T) {
+		result := Fallbacks(
+			func() string {
+				ThrowNetworkError("db-primary", "connection refused", nil)
+				return ""
+			},
+			func() string {
+				ThrowNetworkError("db-replica", "connection refused", nil)
+				return ""
+			},
+			func() string { return "cache" },
+		)
+
+		if result.Value != "cache" || result.Source != 2 {
+			t.Errorf("Expected cache result from source 2, got %+v", result)
+		}
+		if len(result.Failures) != 2 {
+			t.Errorf("Expected 2 recorded failures, got %d", len(result.Failures))
+		}
+	})
+
+	t.Run("Non-retryable exception is rethrown", func(t *testing.T) {
+		var replicaCalled, caught bool
+
+		Try(func() {
+			Fallbacks(
+				func() int {
+					ThrowArgumentNull("id", "missing id")
+					return 0
+				},
+				func() int {
+					replicaCalled = true
+					return 1
+				},
+			)
+		}).Handle(
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {
+				caught = true
+			}),
+		)
+
+		if !caught {
+			t.Error("ArgumentNullException should be rethrown")
+		}
+		if replicaCalled {
+			t.Error("Secondary should not be called for non-retryable exceptions")
+		}
+	})
+
+	t.Run("Registered policies make custom types retryable", func(t *testing.T) {
+		RegisterPolicy[InvalidOperationException](ExceptionPolicy{Retryable: true})
+		defer UnregisterPolicy[InvalidOperationException]()
+
+		result := Fallbacks(
+			func() int {
+				ThrowInvalidOperation("busy")
+				return 0
+			},
+			func() int { return 7 },
+		)
+
+		if result.Value != 7 {
+			t.Errorf("Expected fallback value 7, got %d", result.Value)
+		}
+	})
+
+	t.Run("All sources failing throws AggregateException", func(t *testing.T) {
+		var count int
+
+		Try(func() {
+			Fallbacks(
+				func() int {
+					ThrowNetworkError("a", "down", nil)
+					return 0
+				},
+				func() int {
+					ThrowNetworkError("b", "down", nil)
+					return 0
+				},
+			)
+		}).Handle(
+			Handler[AggregateException](func(ex AggregateException, full Exception) {
+				count = len(ex.Exceptions)
+			}),
+		)
+
+		if count != 2 {
+			t.Errorf("Expected aggregate of 2 failures, got %d", count)
+		}
+	})
+}
+
+func TestFallback(t *testing.T) {
+	t.Run("Primary value when it succeeds", func(t *testing.T) {
+		value := Fallback(func() int { return 1 }, func(Exception) int { return 2 })
+		if value != 1 {
+			t.Errorf("Expected the primary value, got %d", value)
+		}
+	})
+
+	t.Run("Every exception falls back by default", func(t *testing.T) {
+		var got Exception
+		value := Fallback(func() int {
+			ThrowInvalidOperation("broken")
+			return 1
+		}, func(ex Exception) int {
+			got = ex
+			return 2
+		})
+		if value != 2 || got.TypeName() != "InvalidOperationException" {
+			t.Errorf("Expected the fallback value for the exception, got %d, %v", value, got)
+		}
+	})
+
+	t.Run("Only chosen types fall back", func(t *testing.T) {
+		breaker := NewCircuitBreaker("quotes", WithTripThreshold(1, time.Minute), WithCooldown(time.Minute))
+		quote := func() string {
+			return Fallback(func() (quote string) {
+				breaker.Run(func() {
+					ThrowNetworkError("https://quotes", "unreachable", nil)
+				})
+				return "live"
+			}, func(ex Exception) string {
+				return "cached"
+			}, FallbackOn[CircuitOpenException]())
+		}
+
+		ex := Try(func() { quote() }).GetException()
+		if _, ok := ex.Type.(NetworkException); !ok {
+			t.Fatalf("Other types should propagate, got %v", ex)
+		}
+		if value := quote(); value != "cached" {
+			t.Errorf("Expected the fallback once the circuit opened, got %q", value)
+		}
+	})
+}
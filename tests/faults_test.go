@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestParseFaultMatrix(t *testing.T) {
+	rules, err := ParseFaultMatrix("TimeoutException:0.05@db.*|cache.get; NetworkException:1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rules) != 2 || rules[0].Type != "TimeoutException" || rules[0].Probability != 0.05 ||
+		len(rules[0].Points) != 2 || rules[1].Points != nil {
+		t.Errorf("Unexpected rules %+v", rules)
+	}
+
+	for _, spec := range []string{"TimeoutException", "TimeoutException:2", "TimeoutException:x", "IOException:0.5@[", "IOException:0.5@"} {
+		if _, err := ParseFaultMatrix(spec); err == nil {
+			t.Errorf("Expected an error for %q", spec)
+		}
+	}
+}
+
+func TestFaultInjector(t *testing.T) {
+	injector := NewFaultInjector(
+		FaultRule{Type: "TimeoutException", Probability: 1, Points: []string{"db.*"}},
+		FaultRule{Type: "NoSuchException", Probability: 1, Points: []string{"queue.push"}},
+		FaultRule{Type: "IOException", Probability: 0},
+	)
+
+	t.Run("Matching point throws the named type", func(t *testing.T) {
+		var point string
+		Try(func() {
+			injector.Inject("db.query")
+		}).Handle(
+			Handler[TimeoutException](func(ex TimeoutException, full Exception) {
+				point, _ = GetTyped(&full, FaultPointKey)
+			}),
+		)
+		if point != "db.query" {
+			t.Errorf("Expected a TimeoutException at db.query, got %q", point)
+		}
+	})
+
+	t.Run("Other points and zero probabilities do not throw", func(t *testing.T) {
+		if ex := Try(func() { injector.Inject("cache.get") }).GetException(); ex != nil {
+			t.Errorf("Unexpected %s", ex.Error())
+		}
+	})
+
+	t.Run("Unknown types throw InjectedFaultException", func(t *testing.T) {
+		ex := Try(func() { injector.Inject("queue.push") }).GetException()
+		if fault, ok := ex.Type.(InjectedFaultException); !ok || fault.Type != "NoSuchException" {
+			t.Errorf("Expected an InjectedFaultException, got %v", ex)
+		}
+	})
+}
+
+func TestInjectFault(t *testing.T) {
+	if FaultsCompiled {
+		t.Skip("built with goexceptions_faults")
+	}
+	if err := ConfigureFaults("IOException:1"); err == nil {
+		t.Error("ConfigureFaults should fail without the goexceptions_faults tag")
+	}
+	if ex := Try(func() { InjectFault("store.get") }).GetException(); ex != nil {
+		t.Errorf("InjectFault should do nothing in production builds, got %s", ex.Error())
+	}
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestFinallyWithin(t *testing.T) {
+	t.Run("Fast cleanup behaves like Finally", func(t *testing.T) {
+		var cleaned bool
+		tr := Try(func() {
+			ThrowInvalidOperation("x")
+		}).Any(func(ex Exception) {}).FinallyWithin(time.Second, func() {
+			cleaned = true
+		})
+
+		if !cleaned || tr == nil {
+			t.Error("Cleanup should complete before returning")
+		}
+	})
+
+	t.Run("Slow cleanup is abandoned and reported", func(t *testing.T) {
+		var mu sync.Mutex
+		var timeout *CleanupTimeoutException
+		release := make(chan struct{})
+		defer close(release)
+
+		start := time.Now()
+		Try(func() {
+			ThrowNetworkError("db", "connection lost", nil)
+		}, WithName("close-db"), WithObserver(ObserverFunc(func(event Event) {
+			if ex, ok := event.Exception.Type.(CleanupTimeoutException); ok {
+				mu.Lock()
+				timeout = &ex
+				mu.Unlock()
+			}
+		}))).Any(func(ex Exception) {}).FinallyWithin(20*time.Millisecond, func() {
+			<-release // hangs on a dead resource
+		})
+
+		if time.Since(start) > time.Second {
+			t.Error("Caller should not wait for the hanging cleanup")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if timeout == nil || timeout.Operation != "close-db" || timeout.Timeout != 20*time.Millisecond {
+			t.Errorf("Expected CleanupTimeoutException for close-db, got %+v", timeout)
+		}
+	})
+
+	t.Run("Panic of an abandoned cleanup is reported", func(t *testing.T) {
+		reported := make(chan string, 1)
+		release := make(chan struct{})
+
+		Try(func() {}, WithObserver(ObserverFunc(func(event Event) {
+			if _, ok := event.Exception.Type.(CleanupTimeoutException); !ok {
+				reported <- event.Exception.Error()
+			}
+		}))).FinallyWithin(10*time.Millisecond, func() {
+			<-release
+			panic("late cleanup failure")
+		})
+		close(release)
+
+		select {
+		case msg := <-reported:
+			if msg == "" {
+				t.Error("Expected a message for the late failure")
+			}
+		case <-time.After(time.Second):
+			t.Error("Late cleanup panic should be reported")
+		}
+	})
+
+	t.Run("Panic within the deadline propagates", func(t *testing.T) {
+		tr := Try(func() {
+			Try(func() {}).FinallyWithin(time.Second, func() {
+				ThrowInvalidOperation("cleanup failed")
+			})
+		})
+
+		if !tr.HasException() || tr.GetException().TypeName() != "InvalidOperationException" {
+			t.Error("Cleanup exception should propagate to the caller")
+		}
+	})
+}
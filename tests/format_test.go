@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestExceptionFormat(t *testing.T) {
+	network := Try(func() {
+		ThrowNetworkError("https://pay", "unreachable", errors.New("reset"))
+	}).GetException()
+	ex := Try(func() {
+		ThrowWithInner(TimeoutException{Operation: "charge"}, network)
+	}).GetException()
+	ex.Data["password"] = "hunter2"
+
+	t.Run("Messages", func(t *testing.T) {
+		for _, format := range []string{"%s", "%v"} {
+			if got := fmt.Sprintf(format, ex); got != ex.Error() {
+				t.Errorf("%s = %q, want the message", format, got)
+			}
+		}
+		if got := fmt.Sprintf("%q", *ex); got != fmt.Sprintf("%q", ex.Error()) {
+			t.Errorf("%%q = %s", got)
+		}
+		if got := fmt.Sprintf("%v", error(*ex)); got != ex.Error() {
+			t.Errorf("%%v of the error = %q", got)
+		}
+	})
+
+	t.Run("Chain with stack traces", func(t *testing.T) {
+		got := fmt.Sprintf("%+v", ex)
+		lines := strings.Split(got, "\n")
+		if lines[0] != ex.Error() || lines[1] != "\t"+ex.StackTrace[0] {
+			t.Errorf("%%+v should start with the message and the first frame, got:\n%s", got)
+		}
+		if !strings.Contains(got, "\ncaused by: "+network.Error()+"\n\t"+network.StackTrace[0]) {
+			t.Errorf("%%+v should follow the inner exception with its frames, got:\n%s", got)
+		}
+	})
+
+	t.Run("Sampled out stacks show the origin", func(t *testing.T) {
+		sampled := *network
+		sampled.StackTrace = nil
+		if got := fmt.Sprintf("%+v", sampled); got != network.Error()+"\n\t"+network.Origin {
+			t.Errorf("%%+v = %q", got)
+		}
+	})
+
+	t.Run("Dump", func(t *testing.T) {
+		RedactKeys("password")
+		defer ClearRedactionRules()
+
+		got := fmt.Sprintf("%#v", ex)
+		for _, want := range []string{"goexceptions.Exception{Type:goexceptions.TimeoutException{", `Operation:"charge"`, "Inner:&goexceptions.Exception{Type:goexceptions.NetworkException{", "Inner:nil}}"} {
+			if !strings.Contains(got, want) {
+				t.Errorf("%%#v lacks %s:\n%s", want, got)
+			}
+		}
+		if strings.Contains(got, "hunter2") {
+			t.Errorf("%%#v should redact data:\n%s", got)
+		}
+	})
+	t.Run("Cyclic values and chains are bounded", func(t *testing.T) {
+		m := map[string]interface{}{}
+		m["self"] = m
+		cyclic := &Exception{Type: ArgumentOutOfRangeException{ParamName: "filter", Value: m}}
+		cyclic.Inner = cyclic
+
+		if got := fmt.Sprintf("%#v", cyclic); !strings.Contains(got, `"[cycle]"`) || !strings.HasSuffix(got, "Inner:&goexceptions.Exception{...}}}}}}}}}") {
+			t.Errorf("%%#v should mark the cycle and cut the chain:\n%s", got)
+		}
+		if got := fmt.Sprintf("%+v", cyclic); strings.Count(got, "caused by:") != 7 {
+			t.Errorf("%%+v should stop after 8 exceptions:\n%s", got)
+		}
+		if got := cyclic.LogValue().String(); strings.Count(got, "inner=") != 7 {
+			t.Errorf("LogValue should stop after 8 exceptions:\n%s", got)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type QuotaException struct {
+	SimpleException
+	Limit int
+}
+
+func init() {
+	gob.Register(QuotaException{})
+}
+
+func roundTrip(t *testing.T, ex *Exception) *Exception {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := SendException(gob.NewEncoder(&buf), ex); err != nil {
+		t.Fatalf("SendException failed: %v", err)
+	}
+	received, err := ReceiveException(gob.NewDecoder(&buf))
+	if err != nil {
+		t.Fatalf("ReceiveException failed: %v", err)
+	}
+	return received
+}
+
+func TestGobTransport(t *testing.T) {
+	t.Run("Built-in types with causes and inner exceptions", func(t *testing.T) {
+		sent := Try(func() {
+			Try(func() {
+				ThrowFileError("users.db", "cannot open", errors.New("permission denied"))
+			}).Any(func(ex Exception) {
+				ex.Data["attempt"] = 3
+				ThrowWithInner(InvalidOperationException{Message: "load failed"}, &ex)
+			})
+		}).GetException()
+
+		received := roundTrip(t, sent)
+		if received.Error() != sent.Error() || received.Origin != sent.Origin {
+			t.Errorf("Expected %q, got %q", sent.Error(), received.Error())
+		}
+		if len(received.StackTrace) != len(sent.StackTrace) {
+			t.Error("Stack trace should be preserved")
+		}
+		inner, ok := received.Inner.Type.(FileException)
+		if !ok || inner.Filename != "users.db" || inner.Cause.Error() != "permission denied" {
+			t.Errorf("Inner FileException should be preserved, got %#v", received.Inner.Type)
+		}
+		if received.Inner.Data["attempt"] != 3 {
+			t.Errorf("Data should be preserved, got %v", received.Inner.Data)
+		}
+	})
+
+	t.Run("Registered custom types", func(t *testing.T) {
+		sent := Try(func() {
+			Throw(QuotaException{SimpleException: SimpleException{Message: "over quota"}, Limit: 10})
+		}).GetException()
+
+		received := roundTrip(t, sent)
+		quota, ok := received.Type.(QuotaException)
+		if !ok || quota.Limit != 10 || received.TypeName() != "QuotaException" {
+			t.Errorf("Custom type should be preserved, got %#v", received.Type)
+		}
+		if received.Error() != sent.Error() {
+			t.Errorf("Expected %q, got %q", sent.Error(), received.Error())
+		}
+	})
+
+	t.Run("Redacted data does not travel", func(t *testing.T) {
+		RedactKeys("password")
+		defer ClearRedactionRules()
+
+		sent := Try(func() { ThrowInvalidOperation("login failed") }).GetException()
+		sent.Data["password"] = "hunter2"
+		sent.Data["user"] = "ada"
+
+		received := roundTrip(t, sent)
+		if received.Data["password"] == "hunter2" || received.Data["user"] != "ada" {
+			t.Errorf("Expected the password redacted, got %v", received.Data)
+		}
+	})
+
+	t.Run("Diagnostic types", func(t *testing.T) {
+		for _, sent := range []ExceptionType{
+			BlockingHandlerException{Handler: "HandlerAny", Threshold: time.Second},
+			SlowHandlerException{Handler: "HandlerAny", P99: 2 * time.Second, Threshold: time.Second, Count: 50},
+			TypeNameCollisionException{Name: "NotFoundException", Existing: "billing.NotFound", Conflicting: "catalog.NotFound"},
+		} {
+			received := roundTrip(t, &Exception{Type: sent})
+			if received.Type != sent {
+				t.Errorf("Expected %#v, got %#v", sent, received.Type)
+			}
+		}
+	})
+
+	t.Run("No exception", func(t *testing.T) {
+		if received := roundTrip(t, nil); received != nil {
+			t.Errorf("Expected nil, got %v", received)
+		}
+	})
+
+	t.Run("Unregistered types fail to encode", func(t *testing.T) {
+		type unregisteredException struct{ InvalidOperationException }
+		ex := &Exception{Type: unregisteredException{}}
+		err := SendException(gob.NewEncoder(&bytes.Buffer{}), ex)
+		if err == nil {
+			t.Error("Expected an error for an unregistered type")
+		}
+	})
+}
@@ -291,6 +291,59 @@ func TestFinallyBlock(t *testing.T) {
 	})
 }
 
+func TestElse(t *testing.T) {
+	t.Run("Else runs after success", func(t *testing.T) {
+		var steps []string
+		Try(func() {
+			steps = append(steps, "block")
+		}).Any(func(Exception) {
+			steps = append(steps, "handler")
+		}).Else(func() {
+			steps = append(steps, "else")
+		}).Finally(func() {
+			steps = append(steps, "finally")
+		})
+
+		if strings.Join(steps, ",") != "block,else,finally" {
+			t.Errorf("Unexpected steps %v", steps)
+		}
+	})
+
+	t.Run("Else is skipped after an exception", func(t *testing.T) {
+		var ran bool
+		Try(func() {
+			ThrowInvalidOperation("failed")
+		}).Any(func(Exception) {}).Else(func() {
+			ran = true
+		}).End()
+
+		if ran {
+			t.Error("Else should not run when the block threw")
+		}
+	})
+
+	t.Run("Else exceptions are not caught by the chain", func(t *testing.T) {
+		var handled bool
+		outer := Try(func() {
+			Try(func() {}).Else(func() {
+				ThrowInvalidOperation("after success")
+			}).Any(func(Exception) { handled = true })
+		})
+
+		if handled || !outer.HasException() {
+			t.Error("An exception from Else should propagate")
+		}
+	})
+
+	t.Run("Else receives values", func(t *testing.T) {
+		var port int
+		Try1(func() int { return 8080 }).Else(func(value int) { port = value })
+		if port != 8080 {
+			t.Errorf("Expected 8080, got %d", port)
+		}
+	})
+}
+
 // Custom exception type for testing
 type CustomException struct {
 	Code    int
@@ -458,3 +511,32 @@ func BenchmarkMultipleHandlers(b *testing.B) {
 		)
 	}
 }
+
+// The throw benchmarks split the cost of a throw. Most of it, and most of its
+// allocations, is capturing the stack trace, which Throw does before Try can
+// intervene, so recycling Exception values would not pay (see the package
+// documentation, after the type cache).
+func benchmarkThrow(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Try(func() {
+			ThrowArgumentNull("param", "test")
+		}, WithoutStack()).Any(func(ex Exception) {}).End()
+	}
+}
+
+func BenchmarkThrowFullStacks(b *testing.B) {
+	benchmarkThrow(b)
+}
+
+func BenchmarkThrowSampledStacks(b *testing.B) {
+	SetStackSampling(1 << 30)
+	defer SetStackSampling(1)
+	benchmarkThrow(b)
+}
+
+func BenchmarkThrowWithoutStacks(b *testing.B) {
+	SetEnabled(SubsystemStacks, false)
+	defer SetEnabled(SubsystemStacks, true)
+	benchmarkThrow(b)
+}
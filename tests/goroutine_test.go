@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestGo(t *testing.T) {
+	t.Run("Exceptions reach the handlers", func(t *testing.T) {
+		handled := make(chan string, 1)
+		Go(func() {
+			ThrowInvalidOperation("background failure")
+		}, Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+			handled <- ex.Message
+		}))
+
+		select {
+		case message := <-handled:
+			if message != "background failure" {
+				t.Errorf("Unexpected message %q", message)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected the handler to run")
+		}
+	})
+
+	t.Run("Unhandled exceptions reach observers", func(t *testing.T) {
+		unhandled := make(chan *Exception, 1)
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled && event.Exception.TypeName() == "IndexOutOfRangeException" {
+				unhandled <- event.Exception
+			}
+		}))
+		defer remove()
+
+		Go(func() {
+			var empty []int
+			_ = empty[3]
+		})
+
+		select {
+		case <-unhandled:
+		case <-time.After(time.Second):
+			t.Fatal("Expected an unhandled event instead of a crash")
+		}
+	})
+}
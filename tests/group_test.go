@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestExceptionGroup(t *testing.T) {
+	t.Run("Zero value waits for every goroutine", func(t *testing.T) {
+		var group ExceptionGroup
+		var ran atomic.Int32
+		for i := 0; i < 5; i++ {
+			group.Go(func() { ran.Add(1) })
+		}
+		if ex := group.Wait(); ex != nil || ran.Load() != 5 {
+			t.Errorf("Expected 5 successful runs, got %d (%v)", ran.Load(), ex)
+		}
+	})
+
+	t.Run("First exception cancels the context", func(t *testing.T) {
+		group, ctx := NewExceptionGroup(context.Background())
+		group.Go(func() { ThrowInvalidOperation("first") })
+		group.Go(func() { ReceiveCtx(ctx, make(chan int)) })
+
+		ex := group.Wait()
+		if ex == nil || ex.TypeName() != "InvalidOperationException" {
+			t.Fatalf("Expected the first exception, got %v", ex)
+		}
+		var invalid InvalidOperationException
+		if !errors.As(context.Cause(ctx), &invalid) {
+			t.Errorf("Expected the exception as the cancellation cause, got %v", context.Cause(ctx))
+		}
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		var group ExceptionGroup
+		group.SetLimit(2)
+		var running, peak atomic.Int32
+		for i := 0; i < 6; i++ {
+			group.Go(func() {
+				current := running.Add(1)
+				for {
+					old := peak.Load()
+					if current <= old || peak.CompareAndSwap(old, current) {
+						break
+					}
+				}
+				time.Sleep(2 * time.Millisecond)
+				running.Add(-1)
+			})
+		}
+		group.Wait()
+		if peak.Load() > 2 {
+			t.Errorf("Expected at most 2 goroutines at once, got %d", peak.Load())
+		}
+	})
+}
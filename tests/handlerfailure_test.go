@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestHandlerPanicIsolation(t *testing.T) {
+	t.Run("Panicking handler does not crash and Finally runs", func(t *testing.T) {
+		var cleaned bool
+
+		tr := Try(func() {
+			ThrowArgumentNull("email", "missing email")
+		}).Handle(
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {
+				var logger map[string]string
+				logger["last"] = ex.Message // buggy logging handler
+			}),
+		).Finally(func() {
+			cleaned = true
+		})
+
+		if !cleaned {
+			t.Error("Finally should run after a handler failure")
+		}
+		if !tr.HandlerFailed() {
+			t.Fatal("TryResult should record the handler failure")
+		}
+
+		failure := tr.HandlerFailure()
+		handlerEx, ok := failure.Type.(HandlerFailureException)
+		if !ok || handlerEx.Handler != "Handler[ArgumentNullException]" {
+			t.Errorf("Unexpected failure: %s", failure.Error())
+		}
+		if !strings.Contains(handlerEx.Failure.Error(), "nil map") {
+			t.Errorf("Failure should describe the panic, got: %s", handlerEx.Failure.Error())
+		}
+		if FindInnerException[ArgumentNullException](failure) == nil {
+			t.Error("Original exception should be chained as inner exception")
+		}
+	})
+
+	t.Run("Every handler syntax is isolated", func(t *testing.T) {
+		throw := func() { ThrowInvalidOperation("x") }
+		buggy := func() { panic("handler bug") }
+
+		results := []*TryResult{
+			Catch(Try(throw), func(ex InvalidOperationException, full Exception) { buggy() }),
+			On(Try(throw).When(), func(ex InvalidOperationException, full Exception) { buggy() }).End(),
+			Try(throw).When().Any(func(ex Exception) { buggy() }).End(),
+			Try(throw).Any(func(ex Exception) { buggy() }),
+			Try(throw).Handle(HandlerAny(func(ex Exception) { buggy() })),
+		}
+		for i, tr := range results {
+			if !tr.HandlerFailed() {
+				t.Errorf("Result %d should record a handler failure", i)
+			}
+		}
+	})
+
+	t.Run("Failure is reported and stops further handlers", func(t *testing.T) {
+		var failures int
+		var secondCalled bool
+
+		tr := Try(func() {
+			ThrowInvalidOperation("x")
+		}, WithObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventHandlerFailed {
+				failures++
+			}
+		}))).Handle(
+			HandlerAny(func(ex Exception) { panic("first handler bug") }),
+			HandlerAny(func(ex Exception) { secondCalled = true }),
+		)
+
+		if failures != 1 {
+			t.Errorf("Expected one handler failure event, got %d", failures)
+		}
+		if secondCalled {
+			t.Error("Handlers after the failing one should not run")
+		}
+		if tr.Report().HandlerFailure == nil {
+			t.Error("Report should include the handler failure")
+		}
+	})
+
+	t.Run("Exceptions thrown on purpose still propagate", func(t *testing.T) {
+		var translated bool
+
+		Try(func() {
+			Try(func() {
+				ThrowArgumentNull("id", "missing")
+			}).Handle(
+				Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {
+					ThrowInvalidOperation("translated")
+				}),
+			)
+		}).Handle(
+			Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+				translated = true
+			}),
+		)
+
+		if !translated {
+			t.Error("Throw inside a handler should propagate to the outer Try")
+		}
+	})
+
+	t.Run("Healthy handler has no failure", func(t *testing.T) {
+		tr := Try(func() { ThrowInvalidOperation("x") }).Any(func(ex Exception) {})
+		if tr.HandlerFailed() || tr.HandlerFailure() != nil {
+			t.Error("No handler failure expected")
+		}
+	})
+}
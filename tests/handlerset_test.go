@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type userController struct {
+	status int
+}
+
+func (c *userController) HandleMissing(ex ArgumentNullException, full Exception) {
+	c.status = http.StatusBadRequest
+}
+
+func (c *userController) HandleConflict(ex InvalidOperationException, full Exception) {
+	c.status = http.StatusConflict
+}
+
+func (c *userController) HandleAny(full Exception) {
+	c.status = http.StatusInternalServerError
+}
+
+// Not a handler: HTTP-style signature
+func (c *userController) HandleRequest(w http.ResponseWriter, r *http.Request) {}
+
+func TestHandlersFrom(t *testing.T) {
+	c := &userController{}
+
+	Try(func() {
+		ThrowArgumentNull("id", "required")
+	}).Handle(HandlersFrom(c)...)
+	if c.status != http.StatusBadRequest {
+		t.Errorf("Expected typed method to handle, got %d", c.status)
+	}
+
+	report := Try(func() {
+		ThrowInvalidOperation("exists")
+	}).Handle(HandlersFrom(c)...).Report()
+	if c.status != http.StatusConflict || report.Handler != "userController.HandleConflict" {
+		t.Errorf("Expected HandleConflict, got %d from %q", c.status, report.Handler)
+	}
+
+	Try(func() {
+		ThrowFileError("x", "io", nil)
+	}).Handle(HandlersFrom(c)...)
+	if c.status != http.StatusInternalServerError {
+		t.Errorf("Catch-all method should run last, got %d", c.status)
+	}
+
+	if n := len(HandlersFrom(c)); n != 3 {
+		t.Errorf("Expected 3 handlers, got %d", n)
+	}
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func handlerStatsFor(name string) (HandlerStats, bool) {
+	for _, stats := range StatsByHandler() {
+		if stats.Handler == name {
+			return stats, true
+		}
+	}
+	return HandlerStats{}, false
+}
+
+func TestHandlerStats(t *testing.T) {
+	ResetHandlerStats()
+	defer ResetHandlerStats()
+
+	for i := 0; i < 3; i++ {
+		Try(func() { ThrowInvalidOperation("fail") }).Handle(
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {}),
+			Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+				time.Sleep(2 * time.Millisecond)
+			}),
+		)
+	}
+	Try(func() { ThrowInvalidOperation("fail") }).Any(func(Exception) { panic("broken") })
+
+	stats, ok := handlerStatsFor("Handler[InvalidOperationException]")
+	if !ok || stats.Count != 3 || stats.Failures != 0 {
+		t.Fatalf("Unexpected stats %+v", stats)
+	}
+	if stats.Mean() < 2*time.Millisecond || stats.Max < stats.Mean() || stats.Quantile(0.99) < 2*time.Millisecond {
+		t.Errorf("Unexpected latencies: mean %v, max %v, p99 %v", stats.Mean(), stats.Max, stats.Quantile(0.99))
+	}
+	if _, probed := handlerStatsFor("Handler[ArgumentNullException]"); probed {
+		t.Error("Handlers that did not match should not be counted")
+	}
+	if any, ok := handlerStatsFor("Any"); !ok || any.Failures != 1 {
+		t.Errorf("Expected the failing Any handler to be counted, got %+v", any)
+	}
+}
+
+func TestSlowHandlers(t *testing.T) {
+	ResetHandlerStats()
+	defer ResetHandlerStats()
+	SetSlowHandlerThreshold(500 * time.Microsecond)
+	defer SetSlowHandlerThreshold(0)
+
+	var slow []SlowHandlerException
+	remove := AddObserver(ObserverFunc(func(event Event) {
+		if report, ok := event.Exception.Type.(SlowHandlerException); ok {
+			slow = append(slow, report)
+		}
+	}))
+	defer remove()
+
+	for i := 0; i < 60; i++ {
+		Try(func() { ThrowInvalidOperation("fail") }).Handle(
+			Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+				time.Sleep(time.Millisecond)
+			}),
+		)
+		Try(func() { ThrowArgumentNull("id", "missing") }).Any(func(Exception) {})
+	}
+
+	if len(slow) != 1 || slow[0].Handler != "Handler[InvalidOperationException]" || slow[0].P99 <= 500*time.Microsecond {
+		t.Errorf("Expected one slow handler report, got %+v", slow)
+	}
+}
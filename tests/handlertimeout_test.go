@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestHandlerTimeouts(t *testing.T) {
+	t.Run("Slow handler is abandoned and the chain proceeds", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		finallyRan := false
+		start := time.Now()
+		tr := Try(func() {
+			ThrowInvalidOperation("boom")
+		}).Handle(
+			HandlerWithin(20*time.Millisecond, Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+				<-release
+			})),
+			HandlerAny(func(ex Exception) { t.Error("Later handlers should not run") }),
+		).Finally(func() { finallyRan = true })
+
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Handle waited %v for the abandoned handler", elapsed)
+		}
+		if !finallyRan {
+			t.Error("Finally should run after the timeout")
+		}
+		if !tr.HandlerFailed() {
+			t.Fatal("Timeout should be recorded as a handler failure")
+		}
+		timeout, ok := tr.HandlerFailure().Type.(HandlerTimeoutException)
+		if !ok || timeout.Timeout != 20*time.Millisecond || timeout.Handler != "Handler[InvalidOperationException]" {
+			t.Errorf("Unexpected handler failure: %v", tr.HandlerFailure())
+		}
+		if tr.Report().Outcome != OutcomeHandled {
+			t.Errorf("Expected the exception to count as handled, got %s", tr.Report().Outcome)
+		}
+	})
+
+	t.Run("Fast handlers behave like Handle", func(t *testing.T) {
+		var handledBy string
+		Try(func() {
+			ThrowArgumentNull("id", "missing")
+		}).HandleWithin(time.Second,
+			Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) { handledBy = "invalid" }),
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) { handledBy = "null" }),
+		).End()
+
+		if handledBy != "null" {
+			t.Errorf("Expected the matching handler to run, got %q", handledBy)
+		}
+	})
+
+	t.Run("Exceptions thrown by handlers still propagate", func(t *testing.T) {
+		ex := Try(func() {
+			Try(func() {
+				ThrowArgumentNull("id", "missing")
+			}).HandleWithin(time.Second, HandlerAny(func(ex Exception) {
+				ThrowInvalidOperation("translated")
+			}))
+		}).GetException()
+
+		if ex == nil || ex.TypeName() != "InvalidOperationException" {
+			t.Errorf("Expected the translated exception, got %v", ex)
+		}
+	})
+
+	t.Run("Later panic of an abandoned handler is observed", func(t *testing.T) {
+		var mu sync.Mutex
+		var kinds []EventKind
+		panicked := make(chan struct{})
+		observer := ObserverFunc(func(event Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			kinds = append(kinds, event.Kind)
+			if event.Kind == EventUnhandled && event.Exception.TypeName() == "NilReferenceException" {
+				close(panicked)
+			}
+		})
+
+		release := make(chan struct{})
+		Try(func() {
+			ThrowInvalidOperation("boom")
+		}, WithObserver(observer)).HandleWithin(10*time.Millisecond, HandlerAny(func(ex Exception) {
+			<-release
+			var m map[string]int
+			m["x"] = 1
+		})).End()
+		close(release)
+
+		select {
+		case <-panicked:
+		case <-time.After(time.Second):
+			t.Fatal("Abandoned handler panic was not reported")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if kinds[0] != EventCaught || kinds[1] != EventHandled || kinds[2] != EventHandlerFailed {
+			t.Errorf("Unexpected events: %v", kinds)
+		}
+	})
+	t.Run("Abandoned handlers do not reach the finished chain", func(t *testing.T) {
+		release, wrote := make(chan struct{}), make(chan struct{})
+		tr := Try(func() {
+			ThrowInvalidOperation("boom")
+		}).HandleWithin(10*time.Millisecond, HandlerAny(func(full Exception) {
+			<-release
+			full.Data["late"] = true
+			full.Degraded("late")
+			close(wrote)
+		})).End()
+
+		close(release)
+		report := tr.Report()
+		<-wrote
+
+		if _, ok := tr.GetException().Data["late"]; ok || tr.IsDegraded() || report.Outcome == OutcomeDegraded {
+			t.Errorf("Abandoned handler changed the chain: data %v, degraded %q", tr.GetException().Data, tr.DegradedReason())
+		}
+	})
+
+	t.Run("Handlers returning in time keep their changes", func(t *testing.T) {
+		tr := Try(func() {
+			ThrowInvalidOperation("boom")
+		}).HandleWithin(time.Second, HandlerAny(func(full Exception) {
+			full.Data["cache"] = "stale"
+			full.Degraded("served stale cache")
+		}))
+
+		if tr.GetException().Data["cache"] != "stale" || tr.DegradedReason() != "served stale cache" {
+			t.Errorf("Expected the changes carried over: data %v, degraded %q", tr.GetException().Data, tr.DegradedReason())
+		}
+	})
+}
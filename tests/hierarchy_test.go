@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type PaymentException struct {
+	BaseException
+	OrderID string
+}
+
+type CardDeclinedException struct {
+	PaymentException
+	Card string
+}
+
+func throwDeclined() {
+	Throw(CardDeclinedException{
+		PaymentException: PaymentException{
+			BaseException: BaseException{Message: "card declined", Data: map[string]interface{}{"gateway": "acme"}},
+			OrderID:       "A-17",
+		},
+		Card: "visa",
+	})
+}
+
+func TestBaseException(t *testing.T) {
+	t.Run("Defaults describe the thrown type", func(t *testing.T) {
+		ex := Try(throwDeclined).Any(func(Exception) {}).GetException()
+		if ex.TypeName() != "CardDeclinedException" || ex.Error() != "CardDeclinedException: card declined" {
+			t.Errorf("Unexpected name or message: %s / %s", ex.TypeName(), ex.Error())
+		}
+		if ex.Data["gateway"] != "acme" {
+			t.Errorf("Expected the declared data to be copied, got %v", ex.Data)
+		}
+	})
+
+	t.Run("Parent handler catches the child", func(t *testing.T) {
+		var caught PaymentException
+		Try(throwDeclined).Handle(
+			Handler[PaymentException](func(ex PaymentException, full Exception) { caught = ex }),
+		)
+		if caught.OrderID != "A-17" || caught.TypeName() != "CardDeclinedException" {
+			t.Errorf("Expected the embedded parent, got %+v", caught)
+		}
+	})
+
+	t.Run("Root handler and the other syntaxes", func(t *testing.T) {
+		var root, catch, on bool
+		Try(throwDeclined).Handle(Handler[BaseException](func(BaseException, Exception) { root = true }))
+		Catch(Try(throwDeclined), func(PaymentException, Exception) { catch = true })
+		On(Try(throwDeclined).When(), func(PaymentException, Exception) { on = true }).End()
+		if !root || !catch || !on {
+			t.Errorf("Expected every syntax to match the parent: %v %v %v", root, catch, on)
+		}
+
+		outer := &Exception{Type: InvalidOperationException{Message: "checkout"},
+			Inner: &Exception{Type: CardDeclinedException{Card: "amex"}}}
+		if found := FindInnerException[PaymentException](outer); found == nil {
+			t.Error("Expected FindInnerException to find the parent")
+		}
+	})
+
+	t.Run("Child handler does not catch the parent", func(t *testing.T) {
+		var caught bool
+		Try(func() { Throw(PaymentException{OrderID: "B-2"}) }).Handle(
+			Handler[CardDeclinedException](func(CardDeclinedException, Exception) { caught = true }),
+			HandlerAny(func(Exception) {}),
+		)
+		if caught {
+			t.Error("A child handler must not catch its parent")
+		}
+	})
+
+	t.Run("TypeHierarchy", func(t *testing.T) {
+		ex := Try(throwDeclined).Any(func(Exception) {}).GetException()
+		expected := []string{"CardDeclinedException", "PaymentException", "BaseException"}
+		if hierarchy := TypeHierarchy(ex.Type); !reflect.DeepEqual(hierarchy, expected) {
+			t.Errorf("Expected %v, got %v", expected, hierarchy)
+		}
+	})
+}
+
+type paymentController struct {
+	handled string
+}
+
+func (c *paymentController) HandlePayment(ex PaymentException, full Exception) {
+	c.handled = ex.OrderID
+}
+
+func TestHandlersFromHierarchy(t *testing.T) {
+	c := &paymentController{}
+	Try(throwDeclined).Handle(HandlersFrom(c)...)
+	if c.handled != "A-17" {
+		t.Errorf("Expected the parent method to run, got %q", c.handled)
+	}
+}
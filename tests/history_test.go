@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestRangeHistory(t *testing.T) {
+	SetHistoryLimit(100)
+	defer SetHistoryLimit(256)
+
+	for i := 0; i < 150; i++ {
+		Try(func() {
+			ThrowInvalidOperation(fmt.Sprintf("op %d", i))
+		}, WithName("history"), WithTenant("acme")).End()
+	}
+
+	var messages []string
+	RangeHistory(func(entry HistoryEntry) bool {
+		if entry.Name != "history" || entry.Tenant != "acme" {
+			t.Errorf("Unexpected entry: %+v", entry)
+		}
+		messages = append(messages, entry.Exception.Type.(InvalidOperationException).Message)
+		return true
+	})
+
+	if len(messages) != 100 {
+		t.Fatalf("Expected the 100 most recent entries, got %d", len(messages))
+	}
+	if messages[0] != "op 50" || messages[99] != "op 149" {
+		t.Errorf("Expected oldest first from op 50 to op 149, got %s..%s", messages[0], messages[99])
+	}
+
+	t.Run("Stops when fn returns false", func(t *testing.T) {
+		visited := 0
+		RangeHistory(func(entry HistoryEntry) bool {
+			visited++
+			return visited < 3
+		})
+		if visited != 3 {
+			t.Errorf("Expected 3 visits, got %d", visited)
+		}
+	})
+
+	t.Run("Snapshot ignores exceptions caught during iteration", func(t *testing.T) {
+		visited := 0
+		RangeHistory(func(entry HistoryEntry) bool {
+			visited++
+			Try(func() { ThrowInvalidOperation("during") }).End()
+			return true
+		})
+		if visited != 100 {
+			t.Errorf("Expected 100 visits, got %d", visited)
+		}
+	})
+
+	t.Run("Entries keep the exception as caught", func(t *testing.T) {
+		Try(func() { ThrowInvalidOperation("enriched") }).Handle(
+			Enricher(func(ex *Exception) { ex.Data["request_id"] = "req-7" }),
+			HandlerAny(func(full Exception) { full.Data["retried"] = true }),
+		)
+
+		var last HistoryEntry
+		RangeHistory(func(entry HistoryEntry) bool {
+			last = entry
+			return true
+		})
+		if len(last.Exception.Data) != 0 {
+			t.Errorf("Expected the Data of the caught exception, got %v", last.Exception.Data)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		SetHistoryLimit(0)
+		Try(func() { ThrowInvalidOperation("dropped") }).End()
+		RangeHistory(func(entry HistoryEntry) bool {
+			t.Error("History should be empty when disabled")
+			return false
+		})
+	})
+}
+
+func TestRangeConcurrentWithThrows(t *testing.T) {
+	SetHistoryLimit(64)
+	defer SetHistoryLimit(256)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				Try(func() { ThrowInvalidOperation("concurrent") }).End()
+			}
+		}()
+	}
+	for i := 0; i < 50; i++ {
+		RangeHistory(func(entry HistoryEntry) bool {
+			if entry.Exception == nil {
+				t.Error("Snapshot should only contain recorded entries")
+				return false
+			}
+			return true
+		})
+		RangeStats(func(stats ExceptionStats) bool { return stats.Count > 0 })
+	}
+	wg.Wait()
+}
+
+func TestRangeStats(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+
+	Try(func() { ThrowArgumentNull("a", "first") }).End()
+	Try(func() { ThrowInvalidOperation("second") }).End()
+
+	var types []string
+	RangeStats(func(stats ExceptionStats) bool {
+		types = append(types, stats.TypeName)
+		return true
+	})
+	if len(types) != 2 || types[0] != "ArgumentNullException" {
+		t.Errorf("Expected stats in order of first occurrence, got %v", types)
+	}
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestIdempotencySuppression(t *testing.T) {
+	SetDuplicateWindow(50 * time.Millisecond)
+	defer SetDuplicateWindow(0)
+
+	var events []Event
+	remove := AddObserver(ObserverFunc(func(event Event) {
+		if event.Kind == EventUnhandled {
+			events = append(events, event)
+		}
+	}))
+	defer remove()
+
+	charge := func(key string) {
+		Try(func() {
+			ThrowNetworkError("https://payments", "gateway timeout", nil)
+		}, WithIdempotencyKey(key)).End()
+	}
+
+	t.Run("Retries with the same key are reported once", func(t *testing.T) {
+		events = nil
+		for i := 0; i < 5; i++ {
+			charge("order-1")
+		}
+
+		if len(events) != 1 {
+			t.Fatalf("Expected 1 delivered event, got %d", len(events))
+		}
+		if events[0].IdempotencyKey != "order-1" || events[0].Occurrences != 1 {
+			t.Errorf("Unexpected event: key=%s occurrences=%d", events[0].IdempotencyKey, events[0].Occurrences)
+		}
+	})
+
+	t.Run("Suppressed occurrences are counted in the next window", func(t *testing.T) {
+		time.Sleep(60 * time.Millisecond)
+		events = nil
+		charge("order-1")
+
+		if len(events) != 1 || events[0].Occurrences != 5 {
+			t.Fatalf("Expected one event standing for 5 occurrences, got %+v", events)
+		}
+	})
+
+	t.Run("Different keys are reported separately", func(t *testing.T) {
+		events = nil
+		charge("order-2")
+		charge("order-3")
+
+		if len(events) != 2 {
+			t.Errorf("Expected 2 events, got %d", len(events))
+		}
+	})
+
+	t.Run("Try without key is never suppressed", func(t *testing.T) {
+		events = nil
+		for i := 0; i < 3; i++ {
+			Try(func() { ThrowInvalidOperation("no key") }).End()
+		}
+
+		if len(events) != 3 {
+			t.Errorf("Expected 3 events, got %d", len(events))
+		}
+	})
+}
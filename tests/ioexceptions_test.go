@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type failingWriter struct {
+	limit int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		return w.limit, errors.New("disk full")
+	}
+	return len(p), nil
+}
+
+type shortWriter struct{}
+
+func (shortWriter) Write(p []byte) (int, error) {
+	return len(p) / 2, nil
+}
+
+func TestIOException(t *testing.T) {
+	ex := IOException{Operation: "read", Message: "read failed", Cause: errors.New("reset")}
+	if ex.TypeName() != "IOException" {
+		t.Errorf("Unexpected type name: %s", ex.TypeName())
+	}
+	if !strings.Contains(ex.Error(), "reset") || !strings.Contains(ex.Error(), "read") {
+		t.Errorf("Error should mention operation and cause: %s", ex.Error())
+	}
+	if strings.Contains(IOException{Operation: "write", Message: "x"}.Error(), "Cause") {
+		t.Error("Error without cause should not mention a cause")
+	}
+}
+
+func TestThrowingReader(t *testing.T) {
+	t.Run("EOF is not exceptional", func(t *testing.T) {
+		var lines []string
+		tr := Try(func() {
+			scanner := bufio.NewScanner(ThrowingReader(strings.NewReader("a\nb\nc")))
+			for scanner.Scan() {
+				lines = append(lines, scanner.Text())
+			}
+		})
+
+		if tr.HasException() {
+			t.Fatalf("Unexpected exception: %s", tr.GetException().Error())
+		}
+		if len(lines) != 3 {
+			t.Errorf("Expected 3 lines, got %d", len(lines))
+		}
+	})
+
+	t.Run("Read errors are thrown", func(t *testing.T) {
+		var caught bool
+		Try(func() {
+			io.ReadAll(ThrowingReader(iotest.ErrReader(errors.New("connection reset"))))
+		}).Handle(
+			Handler[IOException](func(ex IOException, full Exception) {
+				caught = ex.Operation == "read" && ex.Cause.Error() == "connection reset"
+			}),
+		)
+
+		if !caught {
+			t.Error("Read error should be thrown as IOException")
+		}
+	})
+}
+
+func TestThrowingWriter(t *testing.T) {
+	t.Run("Successful writes", func(t *testing.T) {
+		var buf bytes.Buffer
+		tr := Try(func() {
+			io.Copy(ThrowingWriter(&buf), strings.NewReader("payload"))
+		})
+
+		if tr.HasException() || buf.String() != "payload" {
+			t.Errorf("Expected payload to be written, got %q", buf.String())
+		}
+	})
+
+	t.Run("Write errors are thrown", func(t *testing.T) {
+		var caught bool
+		Try(func() {
+			w := ThrowingWriter(&failingWriter{limit: 4})
+			w.Write([]byte("ok"))
+			w.Write([]byte("too long"))
+		}).Handle(
+			Handler[IOException](func(ex IOException, full Exception) {
+				caught = ex.Operation == "write" && ex.Cause.Error() == "disk full"
+			}),
+		)
+
+		if !caught {
+			t.Error("Write error should be thrown as IOException")
+		}
+	})
+
+	t.Run("Short writes are thrown", func(t *testing.T) {
+		var cause error
+		Try(func() {
+			ThrowingWriter(shortWriter{}).Write([]byte("12345678"))
+		}).Handle(
+			Handler[IOException](func(ex IOException, full Exception) {
+				cause = ex.Cause
+			}),
+		)
+
+		if !errors.Is(cause, io.ErrShortWrite) {
+			t.Errorf("Expected io.ErrShortWrite, got %v", cause)
+		}
+	})
+}
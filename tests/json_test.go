@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestExceptionJSON(t *testing.T) {
+	t.Run("Chains round-trip", func(t *testing.T) {
+		network := Try(func() {
+			ThrowNetworkError("https://pay", "unreachable", errors.New("connection reset"))
+		}).GetException()
+		SetTyped(network, ItemIDKey, "order/7")
+		ex := Try(func() {
+			ThrowWithInner(TimeoutException{Operation: "charge", Message: "gave up"}, network)
+		}).GetException()
+
+		payload, err := json.Marshal(ex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range []string{`"type":"TimeoutException"`, `"message":`, `"fields":`, `"stack":`, `"inner":{"type":"NetworkException"`, `"item_id":"order/7"`} {
+			if !strings.Contains(string(payload), key) {
+				t.Errorf("Expected %s in %s", key, payload)
+			}
+		}
+
+		var back Exception
+		if err := json.Unmarshal(payload, &back); err != nil {
+			t.Fatal(err)
+		}
+		timeout, ok := back.Type.(TimeoutException)
+		if !ok || timeout.Operation != "charge" || back.Origin != ex.Origin || len(back.StackTrace) != len(ex.StackTrace) {
+			t.Errorf("Unexpected decoded exception %+v", back)
+		}
+		inner, ok := back.Inner.Type.(NetworkException)
+		if !ok || inner.URL != "https://pay" || inner.Cause == nil || inner.Cause.Error() != "connection reset" {
+			t.Errorf("Unexpected inner exception %+v", back.Inner)
+		}
+		if back.Inner.Fingerprint() != network.Fingerprint() {
+			t.Error("The inner exception should keep its fingerprint")
+		}
+	})
+
+	t.Run("Custom types known to the process", func(t *testing.T) {
+		Preload[PaymentDeclinedException]()
+		ex := Try(func() {
+			Throw(PaymentDeclinedException{SimpleException: SimpleException{Message: "declined", Code: "card_declined"}, OrderID: "o-1", Amount: 42})
+		}).GetException()
+
+		payload, _ := json.Marshal(ex)
+		var back Exception
+		if err := json.Unmarshal(payload, &back); err != nil {
+			t.Fatal(err)
+		}
+		declined, ok := back.Type.(PaymentDeclinedException)
+		if !ok || declined.OrderID != "o-1" || declined.Amount != 42 || declined.Code != "card_declined" || back.TypeName() != "PaymentDeclinedException" {
+			t.Errorf("Expected the custom type back, got %#v", back.Type)
+		}
+	})
+
+	t.Run("Unknown types", func(t *testing.T) {
+		var back Exception
+		payload := `{"type":"LedgerException","message":"LedgerException: out of balance","code":"ledger_balance","fields":{"Account":"A-9"}}`
+		if err := json.Unmarshal([]byte(payload), &back); err != nil {
+			t.Fatal(err)
+		}
+		remote, ok := back.Type.(RemoteException)
+		if !ok || back.TypeName() != "LedgerException" || back.Error() != "LedgerException: out of balance" || remote.Fields["Account"] != "A-9" {
+			t.Errorf("Expected a RemoteException, got %#v", back.Type)
+		}
+	})
+
+	t.Run("Unknown types stay remote after being caught", func(t *testing.T) {
+		payload := []byte(`{"type":"SettlementException","message":"settlement failed","code":"X1"}`)
+		decode := func() Exception {
+			var back Exception
+			if err := json.Unmarshal(payload, &back); err != nil {
+				t.Fatal(err)
+			}
+			return back
+		}
+
+		first := decode()
+		Catch(Try(func() { first.Rethrow() }), func(RemoteException, Exception) {})
+
+		second := decode()
+		remote, ok := second.Type.(RemoteException)
+		if !ok || remote.Name != "SettlementException" || remote.Message != "settlement failed" || remote.Code != "X1" {
+			t.Errorf("Expected the same RemoteException after a catch, got %#v", second.Type)
+		}
+	})
+
+	t.Run("Data is redacted", func(t *testing.T) {
+		RedactKeys("password")
+		defer ClearRedactionRules()
+
+		ex := Try(func() { ThrowInvalidOperation("login failed") }).GetException()
+		ex.Data["password"] = "hunter2"
+		payload, _ := json.Marshal(ex)
+		if strings.Contains(string(payload), "hunter2") {
+			t.Errorf("Expected redacted data, got %s", payload)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestKnownIssues(t *testing.T) {
+	ex := Try(func() { ThrowInvalidOperation("ledger out of sync") }).GetException()
+	other := Try(func() { ThrowInvalidOperation("ledger out of sync") }).GetException()
+
+	RegisterKnownIssue(ex.Fingerprint(), "PAY-1432", time.Hour)
+	defer UnregisterKnownIssue(ex.Fingerprint())
+
+	t.Run("Lookup and listing", func(t *testing.T) {
+		issue, known := KnownIssueFor(ex)
+		if !known || issue.Ticket != "PAY-1432" {
+			t.Errorf("Expected the known issue, got %+v", issue)
+		}
+		if _, known := KnownIssueFor(other); known {
+			t.Error("A different throw site should not match")
+		}
+		if issues := KnownIssues(); len(issues) != 1 || issues[0].Fingerprint != ex.Fingerprint() {
+			t.Errorf("Unexpected known issues %+v", issues)
+		}
+	})
+
+	t.Run("Logs are downgraded and annotated", func(t *testing.T) {
+		if level := LogLevelFor(ex, false); level != slog.LevelInfo {
+			t.Errorf("Expected info level, got %v", level)
+		}
+		if level := LogLevelFor(other, false); level != slog.LevelError {
+			t.Errorf("Other exceptions keep their level, got %v", level)
+		}
+
+		var buf bytes.Buffer
+		logger := slog.New(NewExceptionLogHandler(slog.NewTextHandler(&buf, nil), ExceptionLogOptions{}))
+		logger.Error("failed", "err", ex)
+		if !strings.Contains(buf.String(), "level=INFO") || !strings.Contains(buf.String(), "err.known_issue=PAY-1432") {
+			t.Errorf("Expected a downgraded, annotated record, got %q", buf.String())
+		}
+	})
+
+	t.Run("Reports carry the ticket", func(t *testing.T) {
+		if summary := ex.Summarize(); summary.KnownIssue != "PAY-1432" {
+			t.Errorf("Expected the ticket in the summary, got %+v", summary)
+		}
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		RegisterKnownIssue(other.Fingerprint(), "PAY-9", time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		if _, known := KnownIssueFor(other); known {
+			t.Error("Expired known issues should not match")
+		}
+	})
+}
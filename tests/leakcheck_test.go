@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestLeakCheck(t *testing.T) {
+	t.Run("Ignored outside debug mode", func(t *testing.T) {
+		tr := Try(func() {}, WithLeakCheck())
+		if _, ok := tr.Resources(); ok {
+			t.Error("Leak check should only run in debug mode")
+		}
+	})
+
+	SetDebugMode(true)
+	defer SetDebugMode(false)
+
+	t.Run("Flags goroutines leaked on the failure path", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		tr := Try(func() {
+			go func() { <-release }()
+			ThrowInvalidOperation("leaky failure")
+		}, WithLeakCheck()).Any(func(ex Exception) {})
+
+		delta, ok := tr.Resources()
+		if !ok || delta.Goroutines < 1 {
+			t.Errorf("Expected one leaked goroutine, got %+v", delta)
+		}
+		report := tr.Report()
+		if !report.LeakSuspected || report.Resources == nil {
+			t.Error("Report should flag the suspected leak")
+		}
+	})
+
+	t.Run("Records allocations of clean blocks", func(t *testing.T) {
+		var sink []byte
+		tr := Try(func() {
+			sink = make([]byte, 1<<16)
+		}, WithLeakCheck())
+		_ = sink
+
+		delta, ok := tr.Resources()
+		if !ok || delta.Bytes < 1<<16 || delta.Allocs == 0 {
+			t.Errorf("Expected allocation deltas, got %+v", delta)
+		}
+		if tr.LeakSuspected() {
+			t.Error("Successful blocks should not be flagged")
+		}
+	})
+}
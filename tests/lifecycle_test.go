@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestLifecycle(t *testing.T) {
+	t.Run("Start runs phases in order", func(t *testing.T) {
+		var order []string
+		lc := NewLifecycle().
+			OnStart("config", func() { order = append(order, "config") }).
+			OnStart("database", func() { order = append(order, "database") })
+
+		tr := Try(func() { lc.Start() })
+
+		if tr.HasException() {
+			t.Fatalf("Start should succeed, got: %s", tr.GetException().Error())
+		}
+		if strings.Join(order, ",") != "config,database" {
+			t.Errorf("Expected phases in registration order, got: %v", order)
+		}
+	})
+
+	t.Run("Failing start phase aborts startup", func(t *testing.T) {
+		var cacheStarted bool
+		lc := NewLifecycle().
+			OnStart("config", func() {}).
+			OnStart("database", func() { ThrowNetworkError("db:5432", "Connection refused", nil) }).
+			OnStart("cache", func() { cacheStarted = true })
+
+		var caught bool
+		Try(func() {
+			lc.Start()
+		}).Handle(
+			Handler[LifecycleException](func(ex LifecycleException, full Exception) {
+				caught = true
+				if ex.Stage != "start" || ex.Phase != "database" {
+					t.Errorf("Expected failing start phase 'database', got %s/%s", ex.Stage, ex.Phase)
+				}
+				if len(ex.Completed) != 1 || ex.Completed[0] != "config" {
+					t.Errorf("Expected completed phases [config], got %v", ex.Completed)
+				}
+				if FindInnerException[NetworkException](&full) == nil {
+					t.Error("Phase exception should be the inner exception")
+				}
+			}),
+		)
+
+		if !caught {
+			t.Error("LifecycleException should have been thrown")
+		}
+		if cacheStarted {
+			t.Error("Phases after the failure should not run")
+		}
+	})
+
+	t.Run("Stop runs in reverse and aggregates failures", func(t *testing.T) {
+		var order []string
+		var lc Lifecycle
+		lc.OnStop("database", func() {
+			order = append(order, "database")
+			ThrowInvalidOperation("pool still in use")
+		})
+		lc.OnStop("cache", func() { order = append(order, "cache") })
+		lc.OnStop("server", func() {
+			order = append(order, "server")
+			ThrowInvalidOperation("listener already closed")
+		})
+
+		var caught bool
+		Try(func() {
+			lc.Stop()
+		}).Handle(
+			Handler[LifecycleException](func(ex LifecycleException, full Exception) {
+				caught = true
+				if ex.Phase != "server, database" {
+					t.Errorf("Expected failed phases 'server, database', got '%s'", ex.Phase)
+				}
+				aggregate := FindInnerException[AggregateException](&full)
+				if aggregate == nil || len(aggregate.Exceptions) != 2 {
+					t.Fatal("Expected an AggregateException with two failures")
+				}
+			}),
+		)
+
+		if !caught {
+			t.Error("LifecycleException should have been thrown")
+		}
+		if strings.Join(order, ",") != "server,cache,database" {
+			t.Errorf("Expected reverse order, got: %v", order)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type StatusException struct {
+	Status  int
+	Message string
+}
+
+func (e StatusException) Error() string    { return e.Message }
+func (e StatusException) TypeName() string { return "StatusException" }
+func (e StatusException) Tags() []string   { return []string{"http"} }
+
+type retryHint interface {
+	RetryAfter() int
+}
+
+type ThrottledException struct {
+	Message string
+}
+
+func (e ThrottledException) Error() string    { return e.Message }
+func (e ThrottledException) TypeName() string { return "ThrottledException" }
+func (e *ThrottledException) RetryAfter() int { return 30 }
+
+func TestMatchers(t *testing.T) {
+	capture := func(exception ExceptionType) *Exception {
+		return Try(func() { Throw(exception) }).GetException()
+	}
+	status := capture(StatusException{Status: 404, Message: "not found"})
+	throttled := capture(ThrottledException{Message: "slow down"})
+
+	cases := []struct {
+		name    string
+		matcher Matcher
+		ex      *Exception
+		want    bool
+	}{
+		{"exact match", MatchExact[StatusException](), status, true},
+		{"exact mismatch", MatchExact[StatusException](), throttled, false},
+		{"assignable to error", MatchAssignable[error](), status, true},
+		{"assignable to retry hint needs a pointer", MatchAssignable[retryHint](), throttled, false},
+		{"interface counts pointer methods", MatchInterface[retryHint](), throttled, true},
+		{"interface mismatch", MatchInterface[retryHint](), status, false},
+		{"name match", MatchName("ThrottledException", "StatusException"), status, true},
+		{"name mismatch", MatchName("NetworkException"), status, false},
+		{"tag match", MatchTag("http"), status, true},
+		{"untagged type", MatchTag("http"), throttled, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.matcher.Match(c.ex); got != c.want {
+				t.Errorf("Match() = %v, want %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("MatchInterface rejects concrete types", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected a panic")
+			}
+		}()
+		MatchInterface[StatusException]()
+	})
+}
+
+func TestHandlerMatch(t *testing.T) {
+	clientErrors := MatcherFunc(func(ex *Exception) bool {
+		status, ok := ex.Type.(StatusException)
+		return ok && status.Status >= 400 && status.Status < 500
+	})
+
+	var selected string
+	report := Try(func() {
+		Throw(StatusException{Status: 404, Message: "not found"})
+	}).Handle(
+		HandlerMatch(MatchName("ThrottledException"), func(ex Exception) { selected = "throttled" }),
+		HandlerMatch(clientErrors, func(ex Exception) { selected = "client" }),
+		HandlerAny(func(ex Exception) { selected = "any" }),
+	).Report()
+
+	if selected != "client" {
+		t.Errorf("Expected the custom matcher to select the handler, got %q", selected)
+	}
+	if report.Handler != "HandlerMatch" {
+		t.Errorf("Unexpected handler name: %q", report.Handler)
+	}
+
+	name := HandlerMatch(MatchTag("http"), func(Exception) {}).(interface{ HandlerName() string }).HandlerName()
+	if name != "HandlerMatch[Tag[http]]" {
+		t.Errorf("Unexpected handler name: %q", name)
+	}
+}
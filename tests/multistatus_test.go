@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestMultiStatus(t *testing.T) {
+	capture := func(block func()) *Exception {
+		return Try(block).GetException()
+	}
+
+	t.Run("AggregateException items", func(t *testing.T) {
+		denied := capture(func() { ThrowAuthorization("eve", "order/2", "cancel") })
+		SetTyped(denied, ItemIDKey, "order/2")
+		declined := capture(func() {
+			Throw(PaymentDeclinedException{SimpleException: SimpleException{Message: "declined", Code: "card_declined"}})
+		})
+
+		ex := capture(func() {
+			Throw(AggregateException{Message: "batch failed", Exceptions: []*Exception{denied, declined}})
+		})
+		ms := MultiStatusFromAggregate(ex)
+
+		if ms.Status != http.StatusMultiStatus || len(ms.Items) != 2 {
+			t.Fatalf("Unexpected payload: %+v", ms)
+		}
+		first, second := ms.Items[0], ms.Items[1]
+		if first.ID != "order/2" || first.Status != http.StatusForbidden || first.Code != "AuthorizationException" {
+			t.Errorf("Unexpected first item: %+v", first)
+		}
+		if second.ID != "1" || second.Status != http.StatusInternalServerError || second.Code != "card_declined" {
+			t.Errorf("Unexpected second item: %+v", second)
+		}
+	})
+
+	t.Run("File batch with pending items", func(t *testing.T) {
+		result := ProcessFiles([]string{"a.csv", "b.csv", "c.csv"}, func(path string) {
+			if path == "b.csv" {
+				ThrowArgumentNull("header", "missing")
+			}
+		}, WithStopOnFailure())
+
+		ms := result.MultiStatus()
+		statuses := []int{http.StatusOK, http.StatusInternalServerError, http.StatusFailedDependency}
+		for i, item := range ms.Items {
+			if item.Status != statuses[i] {
+				t.Errorf("Item %s: expected %d, got %d", item.ID, statuses[i], item.Status)
+			}
+		}
+		if ms.Status != http.StatusMultiStatus {
+			t.Errorf("Expected 207, got %d", ms.Status)
+		}
+	})
+
+	t.Run("WriteMultiStatus", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		ms := ProcessFiles([]string{"a.csv"}, func(string) {}).MultiStatus()
+		if err := WriteMultiStatus(rec, ms); err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded MultiStatus
+		if err := json.Unmarshal(rec.Body.Bytes(), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" || decoded.Items[0].ID != "a.csv" {
+			t.Errorf("Unexpected response: %d %s", rec.Code, rec.Body.String())
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestObservers(t *testing.T) {
+	t.Run("Observer sees caught, handled and unhandled events", func(t *testing.T) {
+		var kinds []EventKind
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			kinds = append(kinds, event.Kind)
+		}))
+		defer remove()
+
+		Try(func() {
+			ThrowArgumentNull("param", "handled")
+		}).Handle(
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {}),
+		).Finally(func() {})
+
+		Try(func() {
+			ThrowInvalidOperation("unhandled")
+		}).Handle(
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {}),
+		).Finally(func() {})
+
+		expected := []EventKind{EventCaught, EventHandled, EventCaught, EventUnhandled}
+		if len(kinds) != len(expected) {
+			t.Fatalf("Expected events %v, got %v", expected, kinds)
+		}
+		for i := range expected {
+			if kinds[i] != expected[i] {
+				t.Errorf("Event %d: expected %s, got %s", i, expected[i], kinds[i])
+			}
+		}
+	})
+
+	t.Run("Unhandled is reported once", func(t *testing.T) {
+		var unhandled int
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled {
+				unhandled++
+			}
+		}))
+		defer remove()
+
+		tr := Try(func() { ThrowInvalidOperation("once") })
+		tr.Finally(func() {})
+		tr.Finally(func() {})
+
+		if unhandled != 1 {
+			t.Errorf("Expected one unhandled event, got %d", unhandled)
+		}
+	})
+
+	t.Run("Removed observers are not notified", func(t *testing.T) {
+		var calls int
+		remove := AddObserver(ObserverFunc(func(event Event) { calls++ }))
+		remove()
+
+		Try(func() { ThrowInvalidOperation("ignored") })
+
+		if calls != 0 {
+			t.Errorf("Removed observer should not be called, got %d calls", calls)
+		}
+	})
+
+	t.Run("Panicking observer does not break handling", func(t *testing.T) {
+		remove := AddObserver(ObserverFunc(func(event Event) { panic("observer bug") }))
+		defer remove()
+
+		var caught bool
+		Try(func() {
+			ThrowInvalidOperation("still handled")
+		}).Any(func(ex Exception) {
+			caught = true
+		})
+
+		if !caught {
+			t.Error("Handler should run even if an observer panics")
+		}
+	})
+}
+
+func TestSLOBurnHook(t *testing.T) {
+	RegisterPolicy[NetworkException](ExceptionPolicy{SLO: SLOAvailability})
+	defer UnregisterPolicy[NetworkException]()
+
+	var burns []SLOBurnEvent
+	remove := AddObserver(SLOObserver(func(burn SLOBurnEvent) {
+		burns = append(burns, burn)
+	}))
+	defer remove()
+
+	Try(func() {
+		ThrowNetworkError("https://api", "timeout", nil)
+	}).Any(func(ex Exception) {})
+
+	Try(func() {
+		ThrowNetworkError("https://api", "timeout", nil)
+	}).Finally(func() {})
+
+	// No SLO category registered: ignored
+	Try(func() {
+		ThrowArgumentNull("param", "validation")
+	}).Finally(func() {})
+
+	if len(burns) != 2 {
+		t.Fatalf("Expected 2 burn events, got %d", len(burns))
+	}
+	if burns[0].Category != SLOAvailability || !burns[0].Handled {
+		t.Errorf("First burn should be a handled availability burn, got %+v", burns[0])
+	}
+	if burns[1].Handled {
+		t.Error("Second burn should be unhandled")
+	}
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type contextKey string
+
+func TestTryOptions(t *testing.T) {
+	t.Run("WithName is attached to events", func(t *testing.T) {
+		var name string
+		Try(func() {
+			ThrowInvalidOperation("named")
+		}, WithName("checkout"), WithObserver(ObserverFunc(func(event Event) {
+			name = event.Name
+		})))
+
+		if name != "checkout" {
+			t.Errorf("Expected event name 'checkout', got '%s'", name)
+		}
+	})
+
+	t.Run("WithContext is retrievable", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextKey("request"), "42")
+		tr := Try(func() {}, WithContext(ctx))
+
+		if tr.Context().Value(contextKey("request")) != "42" {
+			t.Error("Context should be retrievable from the TryResult")
+		}
+		if Try(func() {}).Context() == nil {
+			t.Error("Context should default to context.Background()")
+		}
+	})
+
+	t.Run("WithoutStack drops the stack but keeps the origin", func(t *testing.T) {
+		ex := Try(func() {
+			ThrowInvalidOperation("no stack")
+		}, WithoutStack()).GetException()
+
+		if len(ex.StackTrace) != 0 {
+			t.Error("Stack trace should be dropped")
+		}
+		if ex.Origin == "" {
+			t.Error("Origin should be kept")
+		}
+	})
+
+	t.Run("WithObserver only sees its own Try", func(t *testing.T) {
+		var events int
+		observer := ObserverFunc(func(event Event) { events++ })
+
+		Try(func() {
+			ThrowInvalidOperation("observed")
+		}, WithObserver(observer)).Any(func(ex Exception) {})
+		Try(func() {
+			ThrowInvalidOperation("not observed")
+		}).Any(func(ex Exception) {})
+
+		if events != 2 {
+			t.Errorf("Expected caught and handled events for one Try, got %d", events)
+		}
+	})
+
+	t.Run("WithPolicy overrides the registry", func(t *testing.T) {
+		var burns []SLOBurnEvent
+		remove := AddObserver(SLOObserver(func(burn SLOBurnEvent) {
+			burns = append(burns, burn)
+		}))
+		defer remove()
+
+		tr := Try(func() {
+			ThrowArgumentNull("param", "validation")
+		}, WithPolicy(ExceptionPolicy{SLO: SLOCorrectness}))
+		tr.Finally(func() {})
+
+		if tr.Policy().SLO != SLOCorrectness {
+			t.Error("Policy should reflect the override")
+		}
+		if len(burns) != 1 || burns[0].Category != SLOCorrectness {
+			t.Errorf("Expected one correctness burn, got %+v", burns)
+		}
+	})
+
+	t.Run("Options compose", func(t *testing.T) {
+		var event Event
+		tr := Try(func() {
+			ThrowInvalidOperation("composed")
+		},
+			WithName("import"),
+			WithoutStack(),
+			WithObserver(ObserverFunc(func(e Event) { event = e })),
+		)
+
+		if tr.Name() != "import" || event.Name != "import" {
+			t.Error("Name should be available on the result and events")
+		}
+		if len(tr.GetException().StackTrace) != 0 {
+			t.Error("Stack should be dropped")
+		}
+	})
+
+	t.Run("Zero-option success path does not allocate beyond the result", func(t *testing.T) {
+		block := func() {}
+		allocs := testing.AllocsPerRun(100, func() {
+			Try(block)
+		})
+		if allocs > 1 {
+			t.Errorf("Expected at most 1 allocation, got %.0f", allocs)
+		}
+	})
+}
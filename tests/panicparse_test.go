@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestRuntimePanicClassification(t *testing.T) {
+	t.Run("Index out of range", func(t *testing.T) {
+		items := []int{1, 2, 3}
+		i := 5
+		ex := Try(func() { _ = items[i] }).GetException()
+
+		indexEx, ok := ex.Type.(IndexOutOfRangeException)
+		if !ok || indexEx.Index != 5 || indexEx.Length != 3 {
+			t.Errorf("Expected index 5 with length 3, got %#v", ex.Type)
+		}
+	})
+
+	t.Run("Slice bounds", func(t *testing.T) {
+		items := make([]int, 3)
+		high := 7
+		ex := Try(func() { _ = items[:high] }).GetException()
+
+		indexEx, ok := ex.Type.(IndexOutOfRangeException)
+		if !ok || indexEx.Index != 7 || indexEx.Length != 3 {
+			t.Errorf("Expected index 7 with capacity 3, got %#v", ex.Type)
+		}
+	})
+
+	t.Run("Nil dereference", func(t *testing.T) {
+		var p *struct{ n int }
+		ex := Try(func() { _ = p.n }).GetException()
+
+		if _, ok := ex.Type.(NilReferenceException); !ok {
+			t.Errorf("Expected NilReferenceException, got %#v", ex.Type)
+		}
+	})
+
+	t.Run("Nil map and zero reflect.Value", func(t *testing.T) {
+		var m map[string]int
+		if ex := Try(func() { m["a"] = 1 }).GetException(); ex.TypeName() != "NilReferenceException" {
+			t.Errorf("Expected NilReferenceException for nil map, got %s", ex.TypeName())
+		}
+		if ex := Try(func() { reflect.Value{}.Elem() }).GetException(); ex.TypeName() != "NilReferenceException" {
+			t.Errorf("Expected NilReferenceException for zero Value, got %s", ex.TypeName())
+		}
+	})
+
+	t.Run("Divide by zero", func(t *testing.T) {
+		zero := 0
+		if ex := Try(func() { _ = 1 / zero }).GetException(); ex.TypeName() != "DivideByZeroException" {
+			t.Errorf("Expected DivideByZeroException, got %s", ex.TypeName())
+		}
+	})
+
+	t.Run("Type assertion", func(t *testing.T) {
+		var v interface{} = "text"
+		ex := Try(func() { _ = v.(int) }).GetException()
+
+		assertion, ok := ex.Type.(TypeAssertionException)
+		if !ok || assertion.Interface != "interface {}" || assertion.Concrete != "string" || assertion.Asserted != "int" {
+			t.Errorf("Expected structured assertion failure, got %#v", ex.Type)
+		}
+	})
+
+	t.Run("Unknown messages stay InvalidOperationException", func(t *testing.T) {
+		ex := Try(func() { panic("Something went wrong!") }).GetException()
+		if ex.TypeName() != "InvalidOperationException" {
+			t.Errorf("Expected InvalidOperationException, got %s", ex.TypeName())
+		}
+	})
+}
+
+func TestParsePanic(t *testing.T) {
+	ex, ok := ParsePanic("mylib: index out of range [-1] with length 4")
+	if indexEx, isIndex := ex.(IndexOutOfRangeException); !ok || !isIndex || indexEx.Index != -1 || indexEx.Length != 4 {
+		t.Errorf("Expected parsed library message, got %#v", ex)
+	}
+	if _, ok := ParsePanic(42); ok {
+		t.Error("Non-string values should not be classified")
+	}
+}
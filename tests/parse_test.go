@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+var errConnectionReset = errors.New("connection reset by peer")
+
+func TestParseException(t *testing.T) {
+	ex := ParseException{Line: 3, Column: 7, Snippet: "12x", Message: "invalid number"}
+	for _, part := range []string{"Line: 3", "Column: 7", `"12x"`, "invalid number"} {
+		if !strings.Contains(ex.Error(), part) {
+			t.Errorf("Error should contain %s, got: %s", part, ex.Error())
+		}
+	}
+	if strings.Contains(ParseException{Message: "no position"}.Error(), "Line") {
+		t.Error("Unknown position should not be rendered")
+	}
+}
+
+func TestParseTranslators(t *testing.T) {
+	t.Run("csv.ParseError keeps its position", func(t *testing.T) {
+		var parseEx ParseException
+		Try(func() {
+			reader := csv.NewReader(strings.NewReader("a,b\nc,\"d\n"))
+			_, err := reader.ReadAll()
+			ThrowIfError(err)
+		}).Handle(
+			Handler[ParseException](func(ex ParseException, full Exception) {
+				parseEx = ex
+			}),
+		)
+
+		if parseEx.Line != 2 || parseEx.Column == 0 {
+			t.Errorf("Expected position on line 2, got %d:%d", parseEx.Line, parseEx.Column)
+		}
+		var csvErr *csv.ParseError
+		if !errors.As(parseEx.Cause, &csvErr) {
+			t.Error("Cause should be the original csv.ParseError")
+		}
+	})
+
+	t.Run("strconv errors keep the offending input", func(t *testing.T) {
+		var parseEx ParseException
+		Try(func() {
+			_, err := strconv.Atoi("12x")
+			panic(err)
+		}).Handle(
+			Handler[ParseException](func(ex ParseException, full Exception) {
+				parseEx = ex
+			}),
+		)
+
+		if parseEx.Snippet != "12x" || !strings.Contains(parseEx.Message, "Atoi") {
+			t.Errorf("Unexpected translation: %+v", parseEx)
+		}
+	})
+
+	t.Run("Scanner errors with caller-tracked lines", func(t *testing.T) {
+		var parseEx ParseException
+		Try(func() {
+			scanner := bufio.NewScanner(strings.NewReader("short\n" + strings.Repeat("x", 100)))
+			scanner.Buffer(make([]byte, 16), 16)
+			line := 0
+			for scanner.Scan() {
+				line++
+			}
+			ThrowIfScanError(scanner, line+1)
+		}).Handle(
+			Handler[ParseException](func(ex ParseException, full Exception) {
+				parseEx = ex
+			}),
+		)
+
+		if parseEx.Line != 2 || !errors.Is(parseEx.Cause, bufio.ErrTooLong) {
+			t.Errorf("Expected ErrTooLong on line 2, got %+v", parseEx)
+		}
+	})
+
+	t.Run("Reader errors while scanning become IOException", func(t *testing.T) {
+		var ioEx IOException
+		Try(func() {
+			scanner := bufio.NewScanner(iotest.ErrReader(errConnectionReset))
+			for scanner.Scan() {
+			}
+			ThrowIfScanError(scanner, 1)
+		}).Handle(
+			Handler[IOException](func(ex IOException, full Exception) {
+				ioEx = ex
+			}),
+		)
+
+		if ioEx.Operation != "read" || !errors.Is(ioEx.Cause, errConnectionReset) {
+			t.Errorf("Expected the reader error as an IOException, got %+v", ioEx)
+		}
+	})
+
+	t.Run("Unrecognized errors fall back to InvalidOperationException", func(t *testing.T) {
+		if _, ok := TranslateError(errors.New("boom")).(InvalidOperationException); !ok {
+			t.Error("Expected InvalidOperationException fallback")
+		}
+	})
+
+	t.Run("ThrowIfError ignores nil", func(t *testing.T) {
+		if Try(func() { ThrowIfError(nil) }).HasException() {
+			t.Error("nil error should not throw")
+		}
+	})
+}
+
+func TestCustomTranslator(t *testing.T) {
+	errNotFound := errors.New("record not found")
+	remove := RegisterTranslator(func(err error) (ExceptionType, bool) {
+		if errors.Is(err, errNotFound) {
+			return ArgumentOutOfRangeException{ParamName: "id", Message: err.Error()}, true
+		}
+		return nil, false
+	})
+
+	if _, ok := TranslateError(errNotFound).(ArgumentOutOfRangeException); !ok {
+		t.Error("Registered translator should be used")
+	}
+
+	remove()
+	if _, ok := TranslateError(errNotFound).(InvalidOperationException); !ok {
+		t.Error("Removed translator should no longer be used")
+	}
+}
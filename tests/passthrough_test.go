@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type frameworkSignal struct {
+	code   int
+	detail any
+}
+
+func recovered(block func()) (r any) {
+	defer func() { r = recover() }()
+	block()
+	return nil
+}
+
+func TestPassthrough(t *testing.T) {
+	t.Run("Registered values are re-panicked after Finally", func(t *testing.T) {
+		remove := RegisterPassthrough(http.ErrAbortHandler)
+		defer remove()
+
+		var order []string
+		r := recovered(func() {
+			Try(func() {
+				panic(http.ErrAbortHandler)
+			}).Defer(func() {
+				order = append(order, "defer")
+			}).Finally(func() {
+				order = append(order, "finally")
+			})
+		})
+
+		if r != http.ErrAbortHandler {
+			t.Errorf("Expected the original panic value, got %v", r)
+		}
+		if len(order) != 2 || order[0] != "finally" || order[1] != "defer" {
+			t.Errorf("Expected Finally, then the deferred cleanup, got %v", order)
+		}
+	})
+
+	t.Run("Handler calls re-panic without a terminator", func(t *testing.T) {
+		remove := RegisterPassthrough(http.ErrAbortHandler)
+		defer remove()
+
+		var handled, succeeded bool
+		chains := map[string]func(tr *TryResult){
+			"Handle": func(tr *TryResult) { tr.Handle(HandlerAny(func(Exception) { handled = true })) },
+			"Any":    func(tr *TryResult) { tr.Any(func(Exception) { handled = true }) },
+			"When":   func(tr *TryResult) { On(tr.When(), func(InvalidOperationException, Exception) { handled = true }) },
+			"Else":   func(tr *TryResult) { tr.Else(func() { succeeded = true }) },
+		}
+		for name, chain := range chains {
+			tr := Try(func() { panic(http.ErrAbortHandler) })
+			if r := recovered(func() { chain(tr) }); r != http.ErrAbortHandler {
+				t.Errorf("%s: expected the original panic value, got %v", name, r)
+			}
+		}
+		if handled || succeeded {
+			t.Error("Handlers and Else should not run for passthrough values")
+		}
+	})
+
+	t.Run("Errors match with errors.Is", func(t *testing.T) {
+		remove := RegisterPassthrough(http.ErrAbortHandler)
+		defer remove()
+
+		wrapped := fmt.Errorf("aborting: %w", http.ErrAbortHandler)
+		if r := recovered(func() { Try(func() { panic(wrapped) }).End() }); r != wrapped {
+			t.Errorf("Expected the wrapped error untouched, got %v", r)
+		}
+	})
+
+	t.Run("Types match every value", func(t *testing.T) {
+		remove := RegisterPassthroughType[frameworkSignal]()
+		defer remove()
+
+		r := recovered(func() {
+			Try(func() { panic(frameworkSignal{code: 3}) }).Rethrow()
+		})
+		if signal, ok := r.(frameworkSignal); !ok || signal.code != 3 {
+			t.Errorf("Expected the framework signal, got %v", r)
+		}
+	})
+
+	t.Run("Runners do not swallow passthrough values", func(t *testing.T) {
+		remove := RegisterPassthroughType[frameworkSignal]()
+		defer remove()
+
+		attempts := 0
+		r := recovered(func() {
+			Retry(func() {
+				attempts++
+				panic(frameworkSignal{})
+			}, WithBackoff(0, 0))
+		})
+		if _, ok := r.(frameworkSignal); !ok || attempts != 1 {
+			t.Errorf("Expected the signal after one attempt, got %v after %d", r, attempts)
+		}
+	})
+
+	t.Run("Removed and unregistered values are converted", func(t *testing.T) {
+		remove := RegisterPassthrough(errors.New("other"))
+		remove()
+
+		tr := Try(func() { panic(http.ErrAbortHandler) })
+		if !tr.HasException() {
+			t.Error("Unregistered values should become exceptions")
+		}
+		if r := recovered(func() { tr.End() }); r != nil {
+			t.Errorf("Unexpected panic: %v", r)
+		}
+		if tr := Try(func() { panic([]int{1}) }); !tr.HasException() {
+			t.Error("Uncomparable values should become exceptions")
+		}
+		defer RegisterPassthrough(frameworkSignal{code: 1, detail: []int{1}})()
+		if tr := Try(func() { panic(frameworkSignal{code: 1, detail: []int{1}}) }); !tr.HasException() {
+			t.Error("Values holding uncomparable interfaces should become exceptions")
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestPipeline(t *testing.T) {
+	t.Run("Budget split by weight", func(t *testing.T) {
+		var slices []time.Duration
+		record := func(ctx context.Context) {
+			deadline, _ := ctx.Deadline()
+			slices = append(slices, time.Until(deadline))
+		}
+		NewPipeline("checkout", 300*time.Millisecond).
+			Step("reserve", 1, record).
+			Step("charge", 2, record).
+			Run(context.Background())
+
+		if len(slices) != 2 || slices[0] > 110*time.Millisecond || slices[0] < 80*time.Millisecond ||
+			slices[1] < 250*time.Millisecond {
+			t.Errorf("Expected about 100ms then the rest of the budget, got %v", slices)
+		}
+	})
+
+	t.Run("Slow step is blamed", func(t *testing.T) {
+		var charged bool
+		ex := Try(func() {
+			NewPipeline("checkout", 40*time.Millisecond).
+				Step("reserve", 1, func(ctx context.Context) {
+					ReceiveCtx(ctx, make(chan int))
+				}).
+				Step("charge", 1, func(ctx context.Context) { charged = true }).
+				Run(context.Background())
+		}).GetException()
+
+		timeout, ok := ex.Type.(TimeoutException)
+		if !ok || timeout.Operation != "checkout/reserve" || timeout.Timeout > 20*time.Millisecond {
+			t.Fatalf("Expected a TimeoutException for the reserve step, got %v", ex)
+		}
+		if ex.Inner == nil || charged {
+			t.Error("Expected the step's own exception inside and the pipeline to stop")
+		}
+	})
+
+	t.Run("Step exceptions propagate", func(t *testing.T) {
+		ex := Try(func() {
+			NewPipeline("import", time.Second).
+				Step("parse", 0, func(ctx context.Context) { ThrowInvalidOperation("bad input") }).
+				Run(context.Background())
+		}).GetException()
+		if ex == nil || ex.TypeName() != "InvalidOperationException" {
+			t.Errorf("Expected the step's exception, got %v", ex)
+		}
+	})
+}
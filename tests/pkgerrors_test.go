@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// stackError mirrors github.com/pkg/errors: StackTrace returns []Frame, Frame uintptr
+type frame uintptr
+type stackTrace []frame
+
+type stackError struct {
+	msg   string
+	stack []uintptr
+}
+
+func (e *stackError) Error() string { return e.msg }
+
+func (e *stackError) StackTrace() stackTrace {
+	frames := make(stackTrace, len(e.stack))
+	for i, pc := range e.stack {
+		frames[i] = frame(pc)
+	}
+	return frames
+}
+
+func newStackError(msg string) error {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	return &stackError{msg: msg, stack: pcs[:n]}
+}
+
+func failDeepInRepository() error {
+	return newStackError("connection reset")
+}
+
+func TestStackTraceOf(t *testing.T) {
+	err := fmt.Errorf("load user: %w", failDeepInRepository())
+
+	frames := StackTraceOf(err)
+	if len(frames) == 0 || !strings.Contains(frames[0], "failDeepInRepository") {
+		t.Fatalf("Expected the recorded stack to start at the failure, got %v", frames)
+	}
+	if StackTraceOf(errors.New("plain")) != nil {
+		t.Error("Errors without a recorded stack should return nil")
+	}
+
+	t.Run("Used when translating errors", func(t *testing.T) {
+		ex := Try(func() {
+			ThrowIfError(err)
+		}).GetException()
+		if !strings.Contains(ex.StackTrace[0], "failDeepInRepository") {
+			t.Errorf("Exception should keep the pkg/errors stack, got %v", ex.StackTrace)
+		}
+
+		panicked := Try(func() {
+			panic(err)
+		}).GetException()
+		if !strings.Contains(panicked.StackTrace[0], "failDeepInRepository") {
+			t.Errorf("Panicked errors should keep the pkg/errors stack, got %v", panicked.StackTrace)
+		}
+	})
+}
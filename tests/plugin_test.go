@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func pluginGreet(name string, punctuation ...string) string {
+	return "hello " + name + strings.Join(punctuation, "")
+}
+
+func pluginCrash(n int) int {
+	return 10 / n
+}
+
+func TestCallPlugin(t *testing.T) {
+	t.Run("Calls the symbol and returns its results", func(t *testing.T) {
+		results := CallPlugin(reflect.ValueOf(pluginGreet), "gopher", "!", "?")
+		if len(results) != 1 || results[0] != "hello gopher!?" {
+			t.Errorf("Unexpected results: %v", results)
+		}
+
+		// plugin.Lookup returns a pointer for function variables
+		greet := pluginGreet
+		results = CallPlugin(reflect.ValueOf(&greet), "world")
+		if results[0] != "hello world" {
+			t.Errorf("Unexpected results: %v", results)
+		}
+	})
+
+	t.Run("Plugin panics are wrapped in PluginException", func(t *testing.T) {
+		var caught PluginException
+		var inner *Exception
+		Try(func() {
+			CallPlugin(reflect.ValueOf(pluginCrash), 0)
+		}).Handle(Handler[PluginException](func(ex PluginException, full Exception) {
+			caught, inner = ex, full.Inner
+		}))
+
+		if !strings.HasSuffix(caught.Function, "pluginCrash") {
+			t.Errorf("Unexpected function name: %q", caught.Function)
+		}
+		if inner == nil || inner.TypeName() != "DivideByZeroException" {
+			t.Errorf("Expected the converted panic as inner exception, got %v", inner)
+		}
+	})
+
+	t.Run("Arguments are validated before the call", func(t *testing.T) {
+		cases := []struct {
+			name  string
+			fn    reflect.Value
+			args  []any
+			index int
+		}{
+			{"too few arguments", reflect.ValueOf(pluginCrash), nil, -1},
+			{"too many arguments", reflect.ValueOf(pluginCrash), []any{1, 2}, -1},
+			{"wrong type", reflect.ValueOf(pluginCrash), []any{"1"}, 0},
+			{"wrong variadic type", reflect.ValueOf(pluginGreet), []any{"a", "b", 3}, 2},
+			{"nil for a value parameter", reflect.ValueOf(pluginCrash), []any{nil}, 0},
+			{"not a function", reflect.ValueOf(42), nil, -1},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				ex := Try(func() { CallPlugin(c.fn, c.args...) }).GetException()
+				argument, ok := ex.Type.(PluginArgumentException)
+				if !ok {
+					t.Fatalf("Expected PluginArgumentException, got %v", ex)
+				}
+				if argument.Index != c.index {
+					t.Errorf("Expected index %d, got %d", c.index, argument.Index)
+				}
+			})
+		}
+	})
+
+	t.Run("Nil symbols throw ArgumentNullException", func(t *testing.T) {
+		var missing func()
+		for _, fn := range []reflect.Value{{}, reflect.ValueOf(missing)} {
+			ex := Try(func() { CallPlugin(fn) }).GetException()
+			if ex == nil || ex.TypeName() != "ArgumentNullException" {
+				t.Errorf("Expected ArgumentNullException, got %v", ex)
+			}
+		}
+	})
+
+	t.Run("Nil is accepted for nilable parameters", func(t *testing.T) {
+		results := CallPlugin(reflect.ValueOf(func(m map[string]int) int { return len(m) }), nil)
+		if results[0] != 0 {
+			t.Errorf("Unexpected results: %v", results)
+		}
+	})
+}
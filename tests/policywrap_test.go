@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestPolicyWrap(t *testing.T) {
+	t.Run("Policies apply outermost first", func(t *testing.T) {
+		var order []string
+		layer := func(name string) Policy {
+			return PolicyFunc(func(block func()) {
+				order = append(order, name)
+				block()
+			})
+		}
+
+		Wrap(layer("outer"), layer("inner")).Run(func() { order = append(order, "block") })
+		if len(order) != 3 || order[0] != "outer" || order[1] != "inner" || order[2] != "block" {
+			t.Errorf("Unexpected order %v", order)
+		}
+	})
+
+	t.Run("The innermost exception chain is preserved", func(t *testing.T) {
+		attempts := 0
+		ex := Wrap(
+			RetryPolicy(WithMaxAttempts(2), WithBackoff(0, 0)),
+			NewCircuitBreaker("prices", WithTripThreshold(10, time.Minute)),
+			TimeoutPolicy(time.Second),
+		).Try(func() {
+			attempts++
+			ThrowNetworkError("https://prices", "unreachable", nil)
+		}).GetException()
+
+		if _, ok := ex.Type.(RetryExhaustedException); !ok || attempts != 2 {
+			t.Fatalf("Expected RetryExhaustedException after 2 attempts, got %v after %d", ex, attempts)
+		}
+		var network NetworkException
+		if !errors.As(ex, &network) || network.URL != "https://prices" {
+			t.Errorf("The block's exception should stay in the chain, got %v", ex)
+		}
+	})
+
+	t.Run("Timeouts and fallbacks", func(t *testing.T) {
+		var fellBack string
+		resilient := Wrap(
+			FallbackPolicy(func(ex Exception) { fellBack = ex.TypeName() }, FallbackOn[TimeoutException]()),
+			TimeoutPolicy(10*time.Millisecond),
+		)
+
+		if ex := resilient.Try(func() { time.Sleep(100 * time.Millisecond) }).GetException(); ex != nil {
+			t.Errorf("The fallback should absorb the timeout, got %v", ex)
+		}
+		if fellBack != "TimeoutException" {
+			t.Errorf("Expected a fallback for TimeoutException, got %q", fellBack)
+		}
+
+		ex := resilient.Try(func() { ThrowInvalidOperation("bad state") }).GetException()
+		if _, ok := ex.Type.(InvalidOperationException); !ok {
+			t.Errorf("Other exceptions should propagate, got %v", ex)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"bytes"
+	"runtime/pprof"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func goroutineLabels() string {
+	var buf bytes.Buffer
+	pprof.Lookup("goroutine").WriteTo(&buf, 1)
+	return buf.String()
+}
+
+func TestProfilerLabels(t *testing.T) {
+	var inBlock, inHandler string
+
+	Try(func() {
+		inBlock = goroutineLabels()
+		ThrowInvalidOperation("labeled")
+	}, WithName("label-checkout")).Handle(
+		Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {
+			inHandler = goroutineLabels()
+		}),
+	)
+
+	if !strings.Contains(inBlock, `"operation":"label-checkout"`) {
+		t.Error("Named Try block should run with the operation label")
+	}
+	if !strings.Contains(inHandler, `"exception_type":"InvalidOperationException"`) {
+		t.Error("Handler should run with the exception type label")
+	}
+	if strings.Contains(goroutineLabels(), `"operation":"label-checkout"`) {
+		t.Error("Labels should be removed after the Try")
+	}
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestDataRedaction(t *testing.T) {
+	t.Run("Keys matching patterns are redacted", func(t *testing.T) {
+		RedactKeys("password", "*TOKEN*")
+		defer ClearRedactionRules()
+
+		ex := Exception{
+			Type: InvalidOperationException{Message: "login failed"},
+			Data: map[string]interface{}{
+				"password":     "hunter2",
+				"access_token": "abc123",
+				"username":     "alice",
+			},
+		}
+
+		data := ex.RedactedData()
+		if data["password"] != RedactedValue {
+			t.Errorf("password should be redacted, got %v", data["password"])
+		}
+		if data["access_token"] != RedactedValue {
+			t.Errorf("access_token should be redacted, got %v", data["access_token"])
+		}
+		if data["username"] != "alice" {
+			t.Errorf("username should not be redacted, got %v", data["username"])
+		}
+		if ex.Data["password"] != "hunter2" {
+			t.Error("Original Data should be left untouched for local handling")
+		}
+	})
+
+	t.Run("Custom redaction rules", func(t *testing.T) {
+		RedactWith(func(key string, value interface{}) (interface{}, bool) {
+			if s, ok := value.(string); ok && strings.HasPrefix(s, "4111") {
+				return "****" + s[len(s)-4:], true
+			}
+			return nil, false
+		})
+		defer ClearRedactionRules()
+
+		if got := RedactValue("card", "4111111111111111"); got != "****1111" {
+			t.Errorf("Expected masked card number, got %v", got)
+		}
+		if got := RedactValue("amount", 42); got != 42 {
+			t.Errorf("Non-matching values should pass through, got %v", got)
+		}
+	})
+
+	t.Run("Empty data", func(t *testing.T) {
+		ex := Exception{Type: InvalidOperationException{Message: "empty"}}
+		if ex.RedactedData() != nil {
+			t.Error("RedactedData should be nil for empty Data")
+		}
+	})
+}
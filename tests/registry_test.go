@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type WarehouseException struct {
+	BaseException
+	Warehouse string
+}
+
+type StockShortageException struct {
+	WarehouseException
+	SKU     string
+	Missing int
+}
+
+func TestRegisterExceptionType(t *testing.T) {
+	// as received from another process, before this one threw or caught the type
+	payload := []byte(`{"type":"StockShortageException","message":"not enough stock","fields":{"Message":"not enough stock","Warehouse":"north","SKU":"sku-7","Missing":3}}`)
+
+	t.Run("Unregistered types decode as RemoteException", func(t *testing.T) {
+		var back Exception
+		if err := json.Unmarshal(payload, &back); err != nil {
+			t.Fatal(err)
+		}
+		if remote, ok := back.Type.(RemoteException); !ok || remote.Name != "StockShortageException" {
+			t.Fatalf("Decoded %#v before registration, want a RemoteException", back.Type)
+		}
+		if !HandlerMatch(MatchNamedType("StockShortageException"), func(Exception) {}).Handle(back) {
+			t.Error("MatchNamedType should catch remote exceptions by name")
+		}
+	})
+
+	RegisterExceptionType[WarehouseException]()
+	RegisterExceptionType[StockShortageException]()
+
+	var shortage Exception
+	t.Run("JSON rebuilds registered types", func(t *testing.T) {
+		if err := json.Unmarshal(payload, &shortage); err != nil {
+			t.Fatal(err)
+		}
+		back := shortage
+		decoded, ok := back.Type.(StockShortageException)
+		if !ok || decoded.SKU != "sku-7" || decoded.Missing != 3 || decoded.Warehouse != "north" || decoded.Message != "not enough stock" {
+			t.Errorf("Decoded %#v, want the StockShortageException", back.Type)
+		}
+	})
+
+	t.Run("Gob needs no separate registration", func(t *testing.T) {
+		received := roundTrip(t, &shortage)
+		if decoded, ok := received.Type.(StockShortageException); !ok || decoded.SKU != "sku-7" {
+			t.Errorf("Received %#v, want the StockShortageException", received.Type)
+		}
+	})
+
+	t.Run("MatchNamedType catches types embedding the named one", func(t *testing.T) {
+		var caughtBy string
+		Try(func() { shortage.Rethrow() }).Handle(
+			HandlerMatch(MatchName("WarehouseException"), func(Exception) { caughtBy = "MatchName" }),
+			HandlerMatch(MatchNamedType("WarehouseException"), func(Exception) { caughtBy = "MatchNamedType" }),
+		)
+		if caughtBy != "MatchNamedType" {
+			t.Errorf("Caught by %q, want MatchNamedType", caughtBy)
+		}
+		if HandlerMatch(MatchNamedType("UnknownShortageException"), func(Exception) {}).Handle(shortage) {
+			t.Error("Unknown names should only match by TypeName")
+		}
+	})
+}
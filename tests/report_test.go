@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestTryReport(t *testing.T) {
+	t.Run("Succeeded", func(t *testing.T) {
+		report := Try(func() {
+			time.Sleep(time.Millisecond)
+		}, WithName("transfer")).Report()
+
+		if report.Outcome != OutcomeSucceeded {
+			t.Errorf("Expected succeeded, got %s", report.Outcome)
+		}
+		if report.Name != "transfer" {
+			t.Errorf("Expected name 'transfer', got '%s'", report.Name)
+		}
+		if report.Duration < time.Millisecond {
+			t.Errorf("Duration should cover the block, got %v", report.Duration)
+		}
+		if report.Exception != nil || report.Handler != "" {
+			t.Error("Successful report should have no exception or handler")
+		}
+	})
+
+	t.Run("Handled with matched handler", func(t *testing.T) {
+		report := Try(func() {
+			ThrowArgumentNull("account", "missing account")
+		}).Handle(
+			Handler[InvalidOperationException](func(ex InvalidOperationException, full Exception) {}),
+			Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {}),
+		).Report()
+
+		if report.Outcome != OutcomeHandled {
+			t.Errorf("Expected handled, got %s", report.Outcome)
+		}
+		if report.Handler != "Handler[ArgumentNullException]" {
+			t.Errorf("Unexpected handler: %s", report.Handler)
+		}
+		if report.Exception == nil || report.Exception.Type != "ArgumentNullException" {
+			t.Error("Report should summarize the exception")
+		}
+	})
+
+	t.Run("Handler names for each syntax", func(t *testing.T) {
+		throw := func() { ThrowInvalidOperation("x") }
+
+		catch := Catch(Try(throw), func(ex InvalidOperationException, full Exception) {}).Report()
+		on := On(Try(throw).When(), func(ex InvalidOperationException, full Exception) {}).End().Report()
+		any := Try(throw).Any(func(ex Exception) {}).Report()
+		handlerAny := Try(throw).Handle(HandlerAny(func(ex Exception) {})).Report()
+
+		expected := map[string]string{
+			"Catch[InvalidOperationException]": catch.Handler,
+			"On[InvalidOperationException]":    on.Handler,
+			"Any":                              any.Handler,
+			"HandlerAny":                       handlerAny.Handler,
+		}
+		for want, got := range expected {
+			if want != got {
+				t.Errorf("Expected handler '%s', got '%s'", want, got)
+			}
+		}
+	})
+
+	t.Run("Unhandled", func(t *testing.T) {
+		report := Try(func() {
+			ThrowInvalidOperation("nobody cares")
+		}).Report()
+
+		if report.Outcome != OutcomeUnhandled {
+			t.Errorf("Expected unhandled, got %s", report.Outcome)
+		}
+	})
+
+	t.Run("Degraded", func(t *testing.T) {
+		report := Try(func() {
+			ThrowNetworkError("https://pricing", "timeout", nil)
+		}).Any(func(ex Exception) {
+			ex.Degraded("cached prices")
+		}).Report()
+
+		if report.Outcome != OutcomeDegraded || report.DegradedReason != "cached prices" {
+			t.Errorf("Expected degraded outcome, got %s (%s)", report.Outcome, report.DegradedReason)
+		}
+	})
+
+	t.Run("Summary data is redacted", func(t *testing.T) {
+		RedactKeys("password")
+		defer ClearRedactionRules()
+
+		report := Try(func() {
+			ThrowInvalidOperation("login")
+		}).Any(func(ex Exception) {
+			ex.Data["password"] = "secret"
+		}).Report()
+
+		if report.Exception.Data["password"] != RedactedValue {
+			t.Error("Report data should be redacted")
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func failDeep() {
+	ThrowIOError("read", "disk gone", nil)
+}
+
+func TestRethrowPreservesStack(t *testing.T) {
+	var original Exception
+	outer := Try(func() {
+		Try(failDeep).Handle(
+			Handler[IOException](func(ex IOException, full Exception) {
+				original = full
+				full.Rethrow()
+			}),
+		)
+	}).Any(func(Exception) {}).GetException()
+
+	if outer.TypeName() != "IOException" || outer.Origin != original.Origin {
+		t.Errorf("Expected the original origin %q, got %q", original.Origin, outer.Origin)
+	}
+	if !reflect.DeepEqual(outer.StackTrace, original.StackTrace) || len(outer.StackTrace) == 0 {
+		t.Error("Expected the original stack trace")
+	}
+
+	t.Run("TryResult.Rethrow", func(t *testing.T) {
+		var first *Exception
+		outer := Try(func() {
+			result := Try(failDeep)
+			first = result.GetException()
+			result.Rethrow()
+		}).Any(func(Exception) {}).GetException()
+		if outer.Origin != first.Origin || !reflect.DeepEqual(outer.StackTrace, first.StackTrace) {
+			t.Error("Expected Rethrow to keep the stack")
+		}
+	})
+}
+
+func TestRethrowAs(t *testing.T) {
+	t.Run("From a handler", func(t *testing.T) {
+		var original Exception
+		outer := Try(func() {
+			Try(failDeep).Handle(
+				Handler[IOException](func(ex IOException, full Exception) {
+					original = full
+					full.RethrowAs(InvalidOperationException{Message: "import failed"})
+				}),
+			)
+		}).Any(func(Exception) {}).GetException()
+
+		if outer.TypeName() != "InvalidOperationException" || outer.Inner == nil {
+			t.Fatalf("Expected a wrapper with an inner exception, got %s", outer.GetFullMessage())
+		}
+		if outer.Inner.Origin != original.Origin || !reflect.DeepEqual(outer.Inner.StackTrace, original.StackTrace) {
+			t.Error("Expected the inner exception to keep its stack")
+		}
+		if outer.Origin == original.Origin {
+			t.Error("The wrapper should record where it was thrown")
+		}
+	})
+
+	t.Run("From a TryResult", func(t *testing.T) {
+		outer := Try(func() {
+			Try(failDeep).RethrowAs(InvalidOperationException{Message: "import failed"})
+		}).Any(func(Exception) {}).GetException()
+		if inner := FindInnerException[IOException](outer); inner == nil {
+			t.Errorf("Expected the IOException inside, got %s", outer.GetFullMessage())
+		}
+
+		handled := Try(func() {
+			Try(failDeep).Any(func(Exception) {}).RethrowAs(InvalidOperationException{})
+		})
+		if handled.HasException() {
+			t.Error("A handled exception should not be rethrown")
+		}
+	})
+}
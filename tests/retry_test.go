@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestRetry(t *testing.T) {
+	t.Run("Succeeds after transient failures", func(t *testing.T) {
+		calls := 0
+		value := RetryValue(func() int {
+			calls++
+			if calls < 3 {
+				ThrowNetworkError("api", "timeout", nil)
+			}
+			return 42
+		}, WithBackoff(0, 0))
+
+		if value != 42 || calls != 3 {
+			t.Errorf("Expected 42 after 3 calls, got %d after %d", value, calls)
+		}
+	})
+
+	t.Run("Exhausted retries carry the attempt timeline", func(t *testing.T) {
+		calls := 0
+		tr := Try(func() {
+			Retry(func() {
+				calls++
+				time.Sleep(time.Millisecond)
+				ThrowNetworkError("api", "unavailable", nil)
+			}, WithMaxAttempts(4), WithBackoff(time.Millisecond, 2*time.Millisecond), WithRetryName("fetch-prices"))
+		})
+
+		ex := tr.GetException()
+		exhausted, ok := ex.Type.(RetryExhaustedException)
+		if !ok || exhausted.Operation != "fetch-prices" || calls != 4 {
+			t.Fatalf("Expected RetryExhaustedException after 4 calls, got %v after %d", ex, calls)
+		}
+		if ex.Inner == nil || ex.Inner.TypeName() != "NetworkException" {
+			t.Error("Last failure should be the inner exception")
+		}
+
+		attempts := ex.Attempts()
+		if len(attempts) != 4 {
+			t.Fatalf("Expected 4 attempts, got %d", len(attempts))
+		}
+		for i, attempt := range attempts {
+			if attempt.Number != i+1 || attempt.Duration <= 0 || attempt.Exception.Type != "NetworkException" {
+				t.Errorf("Unexpected attempt %d: %+v", i, attempt)
+			}
+			if i > 0 && !attempt.Start.After(attempts[i-1].Start) {
+				t.Error("Attempts should be in chronological order")
+			}
+		}
+
+		if report := tr.Report(); len(report.Attempts) != 4 {
+			t.Errorf("Report should include the timeline, got %d attempts", len(report.Attempts))
+		}
+	})
+
+	t.Run("Non-retryable exceptions are rethrown at once", func(t *testing.T) {
+		calls := 0
+		ex := Try(func() {
+			Retry(func() {
+				calls++
+				ThrowInvalidOperation("bad request")
+			}, WithBackoff(0, 0))
+		}).GetException()
+
+		if calls != 1 || ex.TypeName() != "InvalidOperationException" || ex.Attempts() != nil {
+			t.Errorf("Expected the original exception after one call, got %v after %d", ex, calls)
+		}
+	})
+
+	t.Run("Custom retry condition", func(t *testing.T) {
+		calls := 0
+		Try(func() {
+			Retry(func() {
+				calls++
+				ThrowInvalidOperation("conflict")
+			}, WithBackoff(0, 0), WithRetryIf(func(ex *Exception) bool {
+				return ex.TypeName() == "InvalidOperationException"
+			}))
+		}).End()
+
+		if calls != 3 {
+			t.Errorf("Expected 3 calls with the default max attempts, got %d", calls)
+		}
+	})
+}
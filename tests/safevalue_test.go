@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type node struct {
+	Name string
+	Next *node
+}
+
+func TestSafeValue(t *testing.T) {
+	t.Run("Ordinary values are unchanged", func(t *testing.T) {
+		order := node{Name: "order"}
+		if SafeValue(order) != order || SafeValue(42) != 42 || SafeValue(nil) != nil {
+			t.Error("Expected safe values to be returned as is")
+		}
+	})
+
+	t.Run("Self-referencing struct", func(t *testing.T) {
+		loop := &node{Name: "a"}
+		loop.Next = &node{Name: "b", Next: loop}
+
+		safe, err := json.Marshal(SafeValue(loop))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(safe), `"[cycle]"`) {
+			t.Errorf("Expected the cycle to be marked, got %s", safe)
+		}
+	})
+
+	t.Run("Self-containing map", func(t *testing.T) {
+		m := map[string]interface{}{"id": 1}
+		m["self"] = m
+		safe := SafeValue(m).(map[string]interface{})
+		if safe["self"] != "[cycle]" || safe["id"] != int64(1) {
+			t.Errorf("Unexpected copy: %v", safe)
+		}
+	})
+
+	t.Run("Huge slices and strings are cut", func(t *testing.T) {
+		safe := SafeValue(make([]int, 100000)).([]interface{})
+		if len(safe) != 65 || !strings.Contains(safe[64].(string), "99936 more") {
+			t.Errorf("Expected 64 elements and a marker, got %d", len(safe))
+		}
+		long := SafeValue(strings.Repeat("x", 10000)).(string)
+		if len(long) > 4200 {
+			t.Errorf("Expected the string to be cut, got %d bytes", len(long))
+		}
+	})
+}
+
+func TestRenderersSurviveCyclicData(t *testing.T) {
+	m := map[string]interface{}{}
+	m["self"] = m
+	cyclic := []interface{}{nil}
+	cyclic[0] = cyclic
+
+	result := Try(func() {
+		ex := Exception{
+			Type: ArgumentOutOfRangeException{ParamName: "filter", Value: m},
+			Data: map[string]interface{}{"filter": m, "path": cyclic},
+		}
+		panic(ex)
+	}).Any(func(Exception) {})
+
+	ex := result.GetException()
+	if !strings.Contains(ex.Error(), "[cycle]") {
+		t.Errorf("Expected the cycle in the message, got %s", ex.Error())
+	}
+	if _, err := json.Marshal(result.Report()); err != nil {
+		t.Errorf("Report should encode to JSON: %v", err)
+	}
+	if err := gob.NewEncoder(&bytes.Buffer{}).Encode(ex); err != nil {
+		t.Errorf("Exception should encode with gob: %v", err)
+	}
+}
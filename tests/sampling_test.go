@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestStackSampling(t *testing.T) {
+	t.Run("Origin is always recorded", func(t *testing.T) {
+		ex := Try(func() {
+			ThrowInvalidOperation("origin test")
+		}).GetException()
+
+		if !strings.Contains(ex.Origin, "sampling_test.go") {
+			t.Errorf("Origin should point at the throw site, got: %s", ex.Origin)
+		}
+		if ex.Fingerprint() == "" {
+			t.Error("Fingerprint should not be empty")
+		}
+	})
+
+	t.Run("Only 1 in N stacks are captured per fingerprint", func(t *testing.T) {
+		SetStackSampling(3)
+		defer SetStackSampling(1)
+		defer ResetStackSampling()
+
+		var withStack int
+		var fingerprints = make(map[string]bool)
+		for i := 0; i < 6; i++ {
+			ex := Try(func() {
+				ThrowInvalidOperation("noisy expected failure")
+			}).GetException()
+
+			fingerprints[ex.Fingerprint()] = true
+			if len(ex.StackTrace) > 0 {
+				withStack++
+			}
+			if ex.Origin == "" {
+				t.Error("Sampled-out exceptions should keep their origin")
+			}
+		}
+
+		if len(fingerprints) != 1 {
+			t.Errorf("Expected a single fingerprint, got %d", len(fingerprints))
+		}
+		// Occurrences 1, 3 and 6 capture a full stack
+		if withStack != 3 {
+			t.Errorf("Expected 3 full stack traces, got %d", withStack)
+		}
+	})
+
+	t.Run("First occurrence always has a stack", func(t *testing.T) {
+		SetStackSampling(1000)
+		defer SetStackSampling(1)
+		defer ResetStackSampling()
+
+		ex := Try(func() {
+			ThrowArgumentNull("first", "first occurrence")
+		}).GetException()
+
+		if len(ex.StackTrace) == 0 {
+			t.Error("First occurrence of a fingerprint should capture a full stack")
+		}
+	})
+
+	t.Run("Different throw sites have different fingerprints", func(t *testing.T) {
+		first := Try(func() { ThrowInvalidOperation("a") }).GetException()
+		second := Try(func() { ThrowInvalidOperation("a") }).GetException()
+
+		if first.Fingerprint() == second.Fingerprint() {
+			t.Error("Fingerprints should differ between throw sites")
+		}
+	})
+}
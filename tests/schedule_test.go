@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Condition not met before deadline")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	t.Run("Job runs periodically", func(t *testing.T) {
+		var runs atomic.Int32
+		job := Schedule(time.Millisecond, func() { runs.Add(1) })
+		waitFor(t, func() bool { return runs.Load() >= 3 })
+		job.Stop()
+
+		if job.Disabled() || job.ConsecutiveFailures() != 0 {
+			t.Error("Successful job should not be disabled")
+		}
+		if job.LastReport().Outcome != OutcomeSucceeded {
+			t.Errorf("Expected last run to succeed, got %s", job.LastReport().Outcome)
+		}
+	})
+
+	t.Run("Job is disabled after consecutive failures", func(t *testing.T) {
+		var disabled atomic.Value
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if ex, ok := event.Exception.Type.(ScheduledJobDisabledException); ok {
+				disabled.Store(ex)
+			}
+		}))
+		defer remove()
+
+		job := Schedule(time.Millisecond, func() {
+			ThrowNetworkError("https://feed", "unreachable", nil)
+		}, WithJobName("feed-sync"), WithDisableAfter(3))
+		waitFor(t, job.Disabled)
+		job.Stop()
+
+		if job.Runs() != 3 {
+			t.Errorf("Expected 3 runs before disabling, got %d", job.Runs())
+		}
+		alert, ok := disabled.Load().(ScheduledJobDisabledException)
+		if !ok || alert.Job != "feed-sync" || alert.Failures != 3 {
+			t.Errorf("Expected disable alert for feed-sync, got %+v", alert)
+		}
+		if job.LastReport().Name != "feed-sync" || job.LastReport().Outcome != OutcomeUnhandled {
+			t.Errorf("Unexpected last report: %+v", job.LastReport())
+		}
+	})
+
+	t.Run("Runs are skipped after repeated failures", func(t *testing.T) {
+		var calls atomic.Int32
+		job := Schedule(time.Millisecond, func() {
+			if calls.Add(1) <= 2 {
+				ThrowInvalidOperation("warming up")
+			}
+		}, WithSkipAfter(2))
+		waitFor(t, func() bool { return calls.Load() >= 4 })
+		job.Stop()
+
+		if job.Skips() != 1 {
+			t.Errorf("Expected one skipped run, got %d", job.Skips())
+		}
+		if job.ConsecutiveFailures() != 0 {
+			t.Error("Failure streak should reset after a success")
+		}
+	})
+
+	t.Run("Failures do not escape in strict mode", func(t *testing.T) {
+		SetStrictMode(true)
+		defer SetStrictMode(false)
+
+		job := Schedule(time.Millisecond, func() {
+			ThrowInvalidOperation("boom")
+		}, WithDisableAfter(2))
+		waitFor(t, job.Disabled)
+		job.Stop()
+
+		if job.LastReport().Outcome != OutcomeUnhandled {
+			t.Errorf("Expected the failure to be reported, got %s", job.LastReport().Outcome)
+		}
+	})
+
+	t.Run("Stop is idempotent", func(t *testing.T) {
+		job := Schedule(time.Hour, func() {})
+		job.Stop()
+		job.Stop()
+	})
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+var errNoRows = errors.New("no rows in result set")
+
+func TestHandlerSentinel(t *testing.T) {
+	t.Run("Matches translated errors", func(t *testing.T) {
+		var caught bool
+		Try(func() {
+			ThrowIfError(fmt.Errorf("query user: %w", errNoRows))
+		}).Handle(
+			HandlerSentinel(io.EOF, func(ex Exception) {
+				t.Error("io.EOF handler should not match")
+			}),
+			HandlerSentinel(errNoRows, func(ex Exception) {
+				caught = true
+			}),
+		)
+		if !caught {
+			t.Error("Sentinel handler should match the wrapped error")
+		}
+	})
+
+	t.Run("Matches panicked errors and causes", func(t *testing.T) {
+		var panicked, caused bool
+		Try(func() {
+			panic(io.ErrUnexpectedEOF)
+		}).Handle(HandlerSentinel(io.ErrUnexpectedEOF, func(ex Exception) {
+			panicked = true
+		}))
+		Try(func() {
+			ThrowIOError("read", "truncated", io.EOF)
+		}).Handle(HandlerSentinel(io.EOF, func(ex Exception) {
+			caused = true
+		}))
+		if !panicked || !caused {
+			t.Errorf("Expected both to match, got panicked=%v caused=%v", panicked, caused)
+		}
+	})
+
+	t.Run("Matches causes of SimpleException types", func(t *testing.T) {
+		var caught bool
+		tr := Try(func() {
+			Throw(PaymentDeclinedException{SimpleException: SimpleException{Message: "gateway hung up", Cause: io.EOF}})
+		})
+		if !errors.Is(tr.Err(), io.EOF) {
+			t.Error("errors.Is should see the Cause")
+		}
+		tr.Handle(HandlerSentinel(io.EOF, func(ex Exception) {
+			caught = true
+		}))
+		if !caught {
+			t.Error("Sentinel handler should match the Cause")
+		}
+	})
+
+	t.Run("Walks inner exceptions", func(t *testing.T) {
+		var caught bool
+		Try(func() {
+			Try(func() {
+				ThrowIfError(errNoRows)
+			}).Any(func(ex Exception) {
+				ThrowWithInner(InvalidOperationException{Message: "load failed"}, &ex)
+			})
+		}).Handle(HandlerSentinel(errNoRows, func(ex Exception) {
+			caught = ex.TypeName() == "InvalidOperationException"
+		}))
+		if !caught {
+			t.Error("Sentinel should match through inner exceptions")
+		}
+	})
+
+	t.Run("Names itself in reports", func(t *testing.T) {
+		report := Try(func() {
+			ThrowIfError(io.EOF)
+		}).Handle(HandlerSentinel(io.EOF, func(Exception) {})).Report()
+		if report.Handler != "HandlerSentinel[EOF]" {
+			t.Errorf("Unexpected handler name %q", report.Handler)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestSeverityScore(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+
+	t.Run("Blame follows status and policy", func(t *testing.T) {
+		cases := []struct {
+			throw func()
+			want  Blame
+		}{
+			{func() { ThrowArgumentNull("id", "required") }, BlameCaller},
+			{func() { ThrowNetworkError("https://pay", "unreachable", errors.New("reset")) }, BlameDependency},
+			{func() { Throw(TimeoutException{Operation: "charge"}) }, BlameDependency},
+			{func() { ThrowInvalidOperation("ledger closed") }, BlameService},
+		}
+		for _, c := range cases {
+			ex := Try(c.throw).GetException()
+			if got := BlameFor(ex); got != c.want {
+				t.Errorf("BlameFor(%s) = %s, want %s", ex.TypeName(), got, c.want)
+			}
+		}
+	})
+
+	t.Run("Unhandled service failures outrank handled caller mistakes", func(t *testing.T) {
+		caller := Try(func() { ThrowArgumentNull("id", "required") }).Any(func(Exception) {})
+		service := Try(func() { ThrowInvalidOperation("ledger closed") }, WithPolicy(ExceptionPolicy{SLO: SLOCorrectness}))
+
+		callerScore, serviceScore := caller.Report().Score, service.Report().Score
+		if callerScore <= 0 || serviceScore <= callerScore || serviceScore > 100 {
+			t.Errorf("Scores caller %.1f, service %.1f: want 0 < caller < service <= 100", callerScore, serviceScore)
+		}
+		if Try(func() {}).Report().Score != 0 {
+			t.Error("Successful Trys should score 0")
+		}
+	})
+
+	t.Run("Frequency raises the score", func(t *testing.T) {
+		throw := func() { ThrowInvalidOperation("cache stale") }
+		first := ScoreFor(Try(throw).GetException(), true)
+		for i := 0; i < 99; i++ {
+			Try(throw).End()
+		}
+		if later := ScoreFor(Try(throw).GetException(), true); later <= first {
+			t.Errorf("Score after 100 occurrences %.1f, want above %.1f", later, first)
+		}
+	})
+
+	t.Run("Scorers are pluggable", func(t *testing.T) {
+		var input ScoreInput
+		SetScorer(ScorerFunc(func(in ScoreInput) float64 {
+			input = in
+			return 42
+		}))
+		defer SetScorer(nil)
+
+		ex := Try(func() { Throw(TimeoutException{Operation: "charge"}) }).GetException()
+		if got := ScoreFor(ex, false); got != 42 {
+			t.Errorf("ScoreFor = %v, want the custom score", got)
+		}
+		if input.Exception != ex || input.Handled || input.Blame != BlameDependency || input.Occurrences != 1 {
+			t.Errorf("Unexpected scorer input %+v", input)
+		}
+	})
+}
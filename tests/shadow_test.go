@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestShadow(t *testing.T) {
+	var divergences []ShadowComparison
+	var candidateRan bool
+	shadow := NewShadow(func(c ShadowComparison) { divergences = append(divergences, c) },
+		Handler[ArgumentException](func(ArgumentException, Exception) { candidateRan = true }),
+		HandlerSentinel(io.EOF, func(Exception) { candidateRan = true }),
+	)
+
+	current := func(handled *string) []ExceptionHandler {
+		return []ExceptionHandler{
+			Handler[ArgumentNullException](func(ArgumentNullException, Exception) { *handled = "null" }),
+			HandlerAny(func(Exception) { *handled = "any" }),
+		}
+	}
+
+	var handled string
+	Try(func() { ThrowArgumentOutOfRange("page", -1, "") }, WithName("list")).
+		HandleShadowed(shadow, current(&handled)...)
+	if handled != "any" || candidateRan {
+		t.Fatalf("Only the current set should run, got %q (candidate ran: %v)", handled, candidateRan)
+	}
+	if len(divergences) != 1 {
+		t.Fatalf("Expected one divergence, got %d", len(divergences))
+	}
+	d := divergences[0]
+	if !d.Diverged() || d.Name != "list" || d.Current != "HandlerAny" || d.Candidate != "Handler[ArgumentException]" {
+		t.Errorf("Unexpected comparison: %+v", d)
+	}
+
+	// Both sets pick a handler with the same name: no divergence
+	Try(func() { ThrowIOError("read", "eof", io.EOF) }).HandleShadowed(shadow,
+		HandlerSentinel(io.EOF, func(Exception) {}),
+	)
+	if len(divergences) != 1 {
+		t.Errorf("Expected no new divergence, got %+v", divergences[1:])
+	}
+
+	// A custom handler cannot be probed
+	Try(func() { ThrowInvalidOperation("custom") }).HandleShadowed(shadow, customHandler{})
+	if len(divergences) != 2 || !divergences[1].Undecided || divergences[1].Diverged() {
+		t.Errorf("Expected an undecided comparison, got %+v", divergences)
+	}
+
+	stats := shadow.Stats()
+	if stats.Compared != 3 || stats.Diverged != 1 || stats.Undecided != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+type customHandler struct{}
+
+func (customHandler) Handle(ex Exception) bool { return true }
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestShutdown(t *testing.T) {
+	t.Run("Flushes async observers, abandoned cleanups and jobs", func(t *testing.T) {
+		var mu sync.Mutex
+		var kinds []string
+		async := NewAsyncObserver(ObserverFunc(func(event Event) {
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			kinds = append(kinds, event.Exception.TypeName())
+			mu.Unlock()
+		}), AsyncConfig{})
+
+		release := make(chan struct{})
+		Try(func() {}, WithObserver(async)).FinallyWithin(time.Millisecond, func() {
+			<-release
+			ThrowFileError("tmp", "late cleanup failure", nil)
+		})
+
+		ran := make(chan struct{}, 100)
+		job := Schedule(time.Millisecond, func() { ran <- struct{}{} })
+		<-ran
+
+		close(release)
+		if err := Shutdown(context.Background()); err != nil {
+			t.Fatalf("Shutdown failed: %v", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(kinds) != 2 || kinds[0] != "CleanupTimeoutException" || kinds[1] != "FileException" {
+			t.Errorf("Expected the timeout and the late failure to be delivered, got %v", kinds)
+		}
+		runs := job.Runs()
+		time.Sleep(5 * time.Millisecond)
+		if job.Runs() != runs {
+			t.Error("Scheduled jobs should be stopped")
+		}
+	})
+
+	t.Run("Gives up when the context is done", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		async := NewAsyncObserver(ObserverFunc(func(event Event) { <-release }), AsyncConfig{})
+		async.Observe(Event{})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := Shutdown(ctx); err != context.DeadlineExceeded {
+			t.Errorf("Expected DeadlineExceeded, got %v", err)
+		}
+	})
+}
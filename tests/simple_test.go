@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type PaymentDeclinedException struct {
+	SimpleException
+	OrderID string
+	Amount  float64
+}
+
+type QueryRejectedException struct {
+	SimpleException
+	Filter map[string]interface{}
+}
+
+func TestSimpleException(t *testing.T) {
+	t.Run("Standalone SimpleException", func(t *testing.T) {
+		ex := SimpleException{Message: "something failed", Code: "E42"}
+
+		if ex.TypeName() != "SimpleException" {
+			t.Errorf("Unexpected TypeName: %s", ex.TypeName())
+		}
+		if ex.Error() != "SimpleException: something failed (Code: E42)" {
+			t.Errorf("Unexpected Error: %s", ex.Error())
+		}
+		if (SimpleException{Message: "plain"}).Error() != "SimpleException: plain" {
+			t.Error("Message-only exception should have no details")
+		}
+	})
+
+	t.Run("Embedded exception is bound on throw", func(t *testing.T) {
+		var caught PaymentDeclinedException
+		tr := Try(func() {
+			Throw(PaymentDeclinedException{
+				SimpleException: SimpleException{Message: "card declined", Code: "card_declined", Cause: errors.New("insufficient funds")},
+				OrderID:         "A-17",
+				Amount:          9.5,
+			})
+		}).Handle(
+			Handler[PaymentDeclinedException](func(ex PaymentDeclinedException, full Exception) {
+				caught = ex
+			}),
+		)
+
+		expected := "PaymentDeclinedException: card declined (Code: card_declined, OrderID: A-17, Amount: 9.5, Cause: insufficient funds)"
+		if caught.Error() != expected {
+			t.Errorf("Unexpected Error:\n got: %s\nwant: %s", caught.Error(), expected)
+		}
+		if caught.TypeName() != "PaymentDeclinedException" || tr.GetException().TypeName() != "PaymentDeclinedException" {
+			t.Errorf("Unexpected TypeName: %s", caught.TypeName())
+		}
+		if caught.OrderID != "A-17" {
+			t.Error("Outer fields should be preserved")
+		}
+	})
+
+	t.Run("Panicking the value directly is bound too", func(t *testing.T) {
+		ex := Try(func() {
+			panic(PaymentDeclinedException{SimpleException: SimpleException{Message: "raw"}, OrderID: "B-1"})
+		}).GetException()
+
+		if ex.TypeName() != "PaymentDeclinedException" {
+			t.Errorf("Unexpected TypeName: %s", ex.TypeName())
+		}
+	})
+	t.Run("Cyclic fields are rendered safely", func(t *testing.T) {
+		filter := map[string]interface{}{}
+		filter["self"] = filter
+
+		ex := Try(func() {
+			Throw(QueryRejectedException{SimpleException: SimpleException{Message: "bad filter"}, Filter: filter})
+		}).GetException()
+
+		if !strings.Contains(ex.Error(), "[cycle]") {
+			t.Errorf("Expected the cycle to be marked, got %s", ex.Error())
+		}
+	})
+}
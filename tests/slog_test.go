@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestLogLevelFor(t *testing.T) {
+	argNull := &Exception{Type: ArgumentNullException{ParamName: "p"}}
+	invalidOp := &Exception{Type: InvalidOperationException{Message: "x"}}
+
+	if level := LogLevelFor(argNull, true); level != slog.LevelDebug {
+		t.Errorf("Handled ArgumentNullException should log at Debug, got %v", level)
+	}
+	if level := LogLevelFor(argNull, false); level != slog.LevelError {
+		t.Errorf("Unhandled ArgumentNullException should log at Error, got %v", level)
+	}
+	if level := LogLevelFor(invalidOp, true); level != slog.LevelInfo {
+		t.Errorf("Handled exceptions should default to Info, got %v", level)
+	}
+
+	RegisterLogLevels[InvalidOperationException](LogLevels{Handled: slog.LevelWarn, Unhandled: slog.LevelError})
+	defer UnregisterLogLevels[InvalidOperationException]()
+	if level := LogLevelFor(invalidOp, true); level != slog.LevelWarn {
+		t.Errorf("Registered level should apply, got %v", level)
+	}
+}
+
+func TestSlogObserver(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	observer := SlogObserver(logger)
+
+	Try(func() {
+		ThrowArgumentNull("email", "required")
+	}, WithObserver(observer)).Handle(
+		Handler[ArgumentNullException](func(ex ArgumentNullException, full Exception) {}),
+	).End()
+
+	if buf.Len() != 0 {
+		t.Errorf("Handled validation failures should stay below Info, got %q", buf.String())
+	}
+
+	Try(func() {
+		ThrowInvalidOperation("boom")
+	}, WithName("checkout"), WithObserver(observer)).End()
+
+	out := buf.String()
+	if !strings.Contains(out, "level=ERROR") || !strings.Contains(out, "exception unhandled") {
+		t.Errorf("Unhandled exception should log at Error, got %q", out)
+	}
+	if !strings.Contains(out, "exception.type=InvalidOperationException") || !strings.Contains(out, "operation=checkout") {
+		t.Errorf("Expected structured fields, got %q", out)
+	}
+}
+
+type coded struct {
+	SimpleException
+}
+
+func TestExceptionLogHandler(t *testing.T) {
+	caught := Try(func() {
+		Throw(coded{SimpleException{Message: "card declined", Code: "card_declined"}})
+	}, WithTenant("acme")).Any(func(Exception) {}).GetException()
+
+	t.Run("Nested under the attribute key", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(NewExceptionLogHandler(slog.NewTextHandler(&buf, nil), ExceptionLogOptions{}))
+		logger.Error("checkout failed", "err", *caught, "order", 17)
+
+		out := buf.String()
+		for _, field := range []string{"err.type=coded", "err.code=card_declined", "err.tenant=acme", "err.fingerprint=", "err.origin=", "order=17"} {
+			if !strings.Contains(out, field) {
+				t.Errorf("Expected %s in %q", field, out)
+			}
+		}
+	})
+
+	t.Run("Flat keys, errors and WithAttrs", func(t *testing.T) {
+		var buf bytes.Buffer
+		handler := NewExceptionLogHandler(slog.NewTextHandler(&buf, nil), ExceptionLogOptions{Group: "exception", Flat: true})
+		logger := slog.New(handler).With("cause", caught.ToError())
+		logger.Warn("retrying", slog.Group("request", "id", "r-1"))
+
+		out := buf.String()
+		if !strings.Contains(out, "exception.type=coded") || !strings.Contains(out, "request.id=r-1") {
+			t.Errorf("Expected flat exception fields, got %q", out)
+		}
+	})
+
+	t.Run("Tenant from the log context", func(t *testing.T) {
+		SetTenantExtractor(func(ctx context.Context) string {
+			tenant, _ := ctx.Value(tenantCtxKey{}).(string)
+			return tenant
+		})
+		defer SetTenantExtractor(nil)
+
+		var buf bytes.Buffer
+		logger := slog.New(NewExceptionLogHandler(slog.NewTextHandler(&buf, nil), ExceptionLogOptions{}))
+		ctx := context.WithValue(context.Background(), tenantCtxKey{}, "globex")
+		logger.ErrorContext(ctx, "failed", "err", &Exception{Type: InvalidOperationException{Message: "x"}})
+
+		if !strings.Contains(buf.String(), "err.tenant=globex") {
+			t.Errorf("Expected the tenant of the context, got %q", buf.String())
+		}
+	})
+}
+
+type tenantCtxKey struct{}
+
+func TestLoggedHandlers(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	tr := CatchLogged[InvalidOperationException](Try(func() {
+		ThrowInvalidOperation("boom")
+	}, WithName("sync")), logger, "sync skipped")
+	tr.End()
+
+	out := buf.String()
+	if tr.Report().Outcome != OutcomeHandled || !strings.Contains(out, "level=INFO") || !strings.Contains(out, `msg="sync skipped"`) {
+		t.Errorf("CatchLogged should log and swallow the exception, got %q", out)
+	}
+	if !strings.Contains(out, "exception.type=InvalidOperationException") || !strings.Contains(out, "operation=sync") {
+		t.Errorf("Expected structured fields, got %q", out)
+	}
+
+	buf.Reset()
+	tr = Try(func() {
+		ThrowInvalidOperation("boom")
+	}).Handle(
+		HandlerLogged[ArgumentNullException](logger, slog.LevelError),
+		HandlerLogged[InvalidOperationException](logger, slog.LevelWarn),
+	)
+	tr.End()
+
+	if tr.Report().Handler != "HandlerLogged[InvalidOperationException]" {
+		t.Errorf("Unexpected handler %q", tr.Report().Handler)
+	}
+	if out := buf.String(); strings.Count(out, "\n") != 1 || !strings.Contains(out, "level=WARN") {
+		t.Errorf("Only the matching handler should log, at its level, got %q", out)
+	}
+}
+
+func TestExceptionLogValue(t *testing.T) {
+	RedactKeys("password")
+	defer ClearRedactionRules()
+
+	network := Try(func() {
+		ThrowNetworkError("https://pay", "unreachable", nil)
+	}).GetException()
+	ex := Try(func() {
+		ThrowWithInner(TimeoutException{Operation: "charge"}, network)
+	}).GetException()
+	ex.Data["order"] = "o-7"
+	ex.Data["password"] = "hunter2"
+
+	decode := func(buf *bytes.Buffer) map[string]any {
+		t.Helper()
+		var record map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+			t.Fatalf("%v: %s", err, buf)
+		}
+		return record
+	}
+
+	t.Run("Attributes become groups", func(t *testing.T) {
+		var buf bytes.Buffer
+		slog.New(slog.NewJSONHandler(&buf, nil)).Error("charge failed", ExceptionAttr("err", ex), ExceptionAttr("none", nil))
+
+		record := decode(&buf)
+		group, _ := record["err"].(map[string]any)
+		data, _ := group["data"].(map[string]any)
+		inner, _ := group["inner"].(map[string]any)
+		switch {
+		case group["type"] != "TimeoutException" || group["fingerprint"] != ex.Fingerprint() || group["origin"] != ex.Origin:
+			t.Errorf("Unexpected exception group %v", group)
+		case data["order"] != "o-7" || data["password"] != RedactedValue:
+			t.Errorf("Data should be logged redacted, got %v", data)
+		case inner["type"] != "NetworkException" || inner["message"] != network.Error():
+			t.Errorf("Inner exception should be nested, got %v", inner)
+		}
+		if stack, _ := group["stack"].([]any); len(stack) != len(ex.StackTrace) {
+			t.Errorf("Stack has %d frames, want %d", len(stack), len(ex.StackTrace))
+		}
+		if _, present := record["none"]; present {
+			t.Error("A nil exception should not be logged")
+		}
+	})
+
+	t.Run("LogException", func(t *testing.T) {
+		var buf bytes.Buffer
+		LogException(slog.New(slog.NewJSONHandler(&buf, nil)), ex, "job", "settle")
+
+		record := decode(&buf)
+		group, _ := record["exception"].(map[string]any)
+		if record["msg"] != ex.Error() || record["level"] != "INFO" || record["job"] != "settle" || group["type"] != "TimeoutException" {
+			t.Errorf("Unexpected record %v", record)
+		}
+	})
+
+	t.Run("NewExceptionLogHandler still expands exceptions", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(NewExceptionLogHandler(slog.NewJSONHandler(&buf, nil), ExceptionLogOptions{}))
+		logger.Error("charge failed", "err", *ex)
+
+		group, _ := decode(&buf)["err"].(map[string]any)
+		if group["type"] != "TimeoutException" || group["stack"] != nil {
+			t.Errorf("Expected the fields of NewExceptionLogHandler, got %v", group)
+		}
+	})
+}
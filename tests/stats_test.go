@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func throwForStats() {
+	Try(func() {
+		ThrowInvalidOperation("stats")
+	}).Any(func(ex Exception) {})
+}
+
+func TestStats(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+
+	for i := 0; i < 3; i++ {
+		throwForStats()
+	}
+	Try(func() {
+		ThrowArgumentNull("p", "other site")
+	}).End()
+
+	stats := Stats()
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 fingerprints, got %d", len(stats))
+	}
+	for _, s := range stats {
+		if s.TypeName == "InvalidOperationException" && s.Count != 3 {
+			t.Errorf("Expected 3 occurrences, got %d", s.Count)
+		}
+		if s.First.IsZero() || s.Last.Before(s.First) {
+			t.Errorf("Unexpected timestamps: %+v", s)
+		}
+	}
+}
+
+func TestStatsByOperation(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+	SetStatsWindow(time.Second)
+	defer SetStatsWindow(0)
+
+	throwFor := func(operation string) {
+		Try(func() { ThrowInvalidOperation("stats") }, WithName(operation)).Any(func(Exception) {})
+	}
+	for i := 0; i < 4; i++ {
+		throwFor("checkout")
+	}
+	throwFor("refund")
+	throwForStats()
+
+	stats := StatsByOperation()
+	if len(stats) != 3 {
+		t.Fatalf("Expected 3 groups, got %+v", stats)
+	}
+	if stats[0].Operation != "" || stats[1].Operation != "checkout" || stats[2].Operation != "refund" {
+		t.Errorf("Unexpected order %+v", stats)
+	}
+	checkout := stats[1]
+	if checkout.Count != 4 || checkout.TypeName != "InvalidOperationException" || checkout.Fingerprint == "" {
+		t.Errorf("Unexpected checkout stats %+v", checkout)
+	}
+	if checkout.Rate < 3 || checkout.Rate > 4 {
+		t.Errorf("Expected about 4 per second, got %v", checkout.Rate)
+	}
+
+	t.Run("Tenants are counted apart", func(t *testing.T) {
+		for _, tenant := range []string{"acme", "acme", "globex"} {
+			Try(func() { ThrowInvalidOperation("stats") }, WithName("checkout"), WithTenant(tenant)).Any(func(Exception) {})
+		}
+
+		acme := TenantStatsByOperation("acme")
+		if len(acme) != 1 || acme[0].Tenant != "acme" || acme[0].Operation != "checkout" || acme[0].Count != 2 {
+			t.Errorf("Unexpected acme stats %+v", acme)
+		}
+		if untagged := TenantStatsByOperation(""); len(untagged) != 3 || untagged[1].Count != 4 {
+			t.Errorf("Trys without a tenant should keep their counters, got %+v", untagged)
+		}
+		if all := StatsByOperation(); len(all) != 5 || all[3].Tenant != "acme" || all[4].Tenant != "globex" {
+			t.Errorf("Expected tenants sorted after untagged Trys, got %+v", all)
+		}
+	})
+}
+
+func TestBurstDetection(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+
+	var bursts []Burst
+	stop := DetectBursts(BurstDetector{
+		Window:   100 * time.Millisecond,
+		Multiple: 3,
+		MinCount: 5,
+		OnBurst:  func(burst Burst) { bursts = append(bursts, burst) },
+	})
+	defer stop()
+
+	// Baseline window: a single occurrence
+	throwForStats()
+	time.Sleep(110 * time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		throwForStats()
+	}
+
+	if len(bursts) != 1 {
+		t.Fatalf("Expected exactly one burst per window, got %d", len(bursts))
+	}
+	if bursts[0].Count != 5 || bursts[0].Baseline != 1 {
+		t.Errorf("Expected burst at count 5 over baseline 1, got %+v", bursts[0])
+	}
+	if bursts[0].TypeName != "InvalidOperationException" || bursts[0].Exception == nil {
+		t.Errorf("Burst should describe the exception, got %+v", bursts[0])
+	}
+
+	t.Run("No burst during the first window", func(t *testing.T) {
+		ResetStats()
+		bursts = nil
+		for i := 0; i < 20; i++ {
+			throwForStats()
+		}
+		if len(bursts) != 0 {
+			t.Errorf("Fingerprints without a baseline should not burst, got %d", len(bursts))
+		}
+	})
+}
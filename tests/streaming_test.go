@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestStreamResponse(t *testing.T) {
+	stream := func(rows int, failAt int) *http.Response {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			StreamResponse(w, func(w http.ResponseWriter) {
+				encoder := json.NewEncoder(w)
+				for i := 0; i < rows; i++ {
+					if i == failAt {
+						ThrowConcurrencyConflict("row", 1, 2)
+					}
+					encoder.Encode(map[string]int{"row": i})
+					http.NewResponseController(w).Flush()
+				}
+			}).Any(func(Exception) {}).End()
+		}))
+		t.Cleanup(server.Close)
+
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	readLines := func(resp *http.Response) []string {
+		var lines []string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		return lines
+	}
+
+	t.Run("Completed stream", func(t *testing.T) {
+		resp := stream(3, -1)
+		lines := readLines(resp)
+		if len(lines) != 3 || resp.Trailer.Get(StreamStatusTrailer) != "200" || resp.Trailer.Get(StreamErrorTrailer) != "" {
+			t.Errorf("Unexpected stream %q with trailers %v", lines, resp.Trailer)
+		}
+	})
+
+	t.Run("Exception mid-stream", func(t *testing.T) {
+		resp := stream(3, 2)
+		lines := readLines(resp)
+		if resp.StatusCode != http.StatusOK || len(lines) != 3 {
+			t.Fatalf("Expected the rows then the error, got %d %q", resp.StatusCode, lines)
+		}
+
+		var last struct{ Error StreamError }
+		if err := json.Unmarshal([]byte(lines[2]), &last); err != nil || last.Error.Status != http.StatusConflict || last.Error.Code != "ConcurrencyException" {
+			t.Errorf("Unexpected error line %q", lines[2])
+		}
+		if resp.Trailer.Get(StreamStatusTrailer) != "409" || resp.Trailer.Get(StreamErrorTrailer) != "ConcurrencyException" {
+			t.Errorf("Unexpected trailers %v", resp.Trailer)
+		}
+	})
+
+	t.Run("Exception before the first write", func(t *testing.T) {
+		resp := stream(3, 0)
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusConflict || !strings.Contains(string(body), `"code":"ConcurrencyException"`) {
+			t.Errorf("Expected a plain error response, got %d %s", resp.StatusCode, body)
+		}
+	})
+
+	t.Run("The exception is left to handle", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		tr := StreamResponse(recorder, func(w http.ResponseWriter) {
+			ThrowInvalidOperation("closed")
+		})
+		if tr.GetException() == nil || tr.Report().Outcome != OutcomeUnhandled {
+			t.Error("Expected the exception to stay unhandled")
+		}
+		tr.Any(func(Exception) {})
+	})
+}
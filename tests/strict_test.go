@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestStrictMode(t *testing.T) {
+	t.Run("Unhandled exceptions escape a strict chain", func(t *testing.T) {
+		var inner *Exception
+		var cleaned bool
+		outer := Try(func() {
+			result := Try(func() {
+				ThrowInvalidOperation("not handled")
+			}, WithStrict(true))
+			inner = result.GetException()
+			result.Handle(
+				Handler[NetworkException](func(ex NetworkException, full Exception) {}),
+			).Finally(func() { cleaned = true })
+		}).GetException()
+
+		if outer == nil || outer.TypeName() != "InvalidOperationException" {
+			t.Fatalf("Expected the exception to escape, got %v", outer)
+		}
+		if !cleaned {
+			t.Error("Finally should run before the exception escapes")
+		}
+		if !reflect.DeepEqual(outer.StackTrace, inner.StackTrace) {
+			t.Error("Expected the original stack trace")
+		}
+	})
+
+	t.Run("Handled exceptions do not escape", func(t *testing.T) {
+		outer := Try(func() {
+			Try(func() {
+				ThrowInvalidOperation("handled")
+			}, WithStrict(true)).Any(func(Exception) {}).End()
+		})
+		if outer.HasException() {
+			t.Errorf("Unexpected %s", outer.GetException().Error())
+		}
+	})
+
+	t.Run("Global mode and per-Try override", func(t *testing.T) {
+		restoreSubsystems(t)
+		if err := Configure("strict=on"); err != nil || !StrictMode() {
+			t.Fatalf("Expected strict mode through Configure, got %v", err)
+		}
+
+		escaped := Try(func() {
+			Try(func() { ThrowInvalidOperation("global") }).End()
+		})
+		if !escaped.HasException() {
+			t.Error("Expected the exception to escape in strict mode")
+		}
+
+		kept := Try(func() {
+			Try(func() { ThrowInvalidOperation("opted out") }, WithStrict(false)).End()
+		})
+		if kept.HasException() {
+			t.Error("WithStrict(false) should keep the exception")
+		}
+
+		err := Try(func() { ThrowInvalidOperation("as error") }).AsError()
+		if err == nil {
+			t.Error("AsError should return the exception instead of throwing it")
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type templateUser struct {
+	Name string
+}
+
+func (u *templateUser) Crash() string {
+	var m map[string]int
+	m["boom"]++
+	return ""
+}
+
+func TestTemplateExecution(t *testing.T) {
+	t.Run("Successful render", func(t *testing.T) {
+		tmpl := texttemplate.Must(texttemplate.New("greeting").Parse("Hello {{.Name}}"))
+
+		var out string
+		tr := Try(func() {
+			out = RenderTemplate(tmpl, templateUser{Name: "Ada"})
+		})
+
+		if tr.HasException() || out != "Hello Ada" {
+			t.Errorf("Unexpected render result %q", out)
+		}
+	})
+
+	t.Run("Execution errors carry template and node", func(t *testing.T) {
+		tmpl := texttemplate.Must(texttemplate.New("profile").Option("missingkey=error").Parse("Name: {{.user.name}}"))
+
+		var templateEx TemplateException
+		Try(func() {
+			ExecuteTemplate(tmpl, &bytes.Buffer{}, map[string]any{})
+		}).Handle(
+			Handler[TemplateException](func(ex TemplateException, full Exception) {
+				templateEx = ex
+			}),
+		)
+
+		if templateEx.Template != "profile" {
+			t.Errorf("Expected template 'profile', got '%s'", templateEx.Template)
+		}
+		if templateEx.Node != ".user.name" || templateEx.Line != 1 {
+			t.Errorf("Expected node .user.name on line 1, got %q line %d", templateEx.Node, templateEx.Line)
+		}
+		if templateEx.Cause == nil {
+			t.Error("Original error should be kept as cause")
+		}
+	})
+
+	t.Run("html/template is supported", func(t *testing.T) {
+		tmpl := htmltemplate.Must(htmltemplate.New("page").Parse("<p>{{.Missing.Field}}</p>"))
+
+		var caught bool
+		Try(func() {
+			RenderTemplate(tmpl, struct{}{})
+		}).Handle(
+			Handler[TemplateException](func(ex TemplateException, full Exception) {
+				caught = ex.Template == "page"
+			}),
+		)
+
+		if !caught {
+			t.Error("html/template failure should be a TemplateException")
+		}
+	})
+
+	t.Run("Panics in template methods become TemplateException", func(t *testing.T) {
+		tmpl := texttemplate.Must(texttemplate.New("crash").Parse("{{.Crash}}"))
+
+		var templateEx TemplateException
+		Try(func() {
+			RenderTemplate(tmpl, &templateUser{})
+		}).Handle(
+			Handler[TemplateException](func(ex TemplateException, full Exception) {
+				templateEx = ex
+			}),
+		)
+
+		if templateEx.Template != "crash" || templateEx.Node != ".Crash" {
+			t.Fatalf("Expected TemplateException for 'crash' at .Crash, got %+v", templateEx)
+		}
+		if !strings.Contains(templateEx.Message, "nil map") {
+			t.Errorf("Message should describe the panic, got: %s", templateEx.Message)
+		}
+	})
+
+	t.Run("Panicking executors are converted", func(t *testing.T) {
+		var inner string
+		Try(func() {
+			ExecuteTemplate(panickingTemplate{}, &bytes.Buffer{}, nil)
+		}).Handle(
+			Handler[TemplateException](func(ex TemplateException, full Exception) {
+				if full.HasInnerException() {
+					inner = full.GetInnerException().Error()
+				}
+			}),
+		)
+
+		if !strings.Contains(inner, "renderer bug") {
+			t.Errorf("Inner exception should describe the panic, got: %s", inner)
+		}
+	})
+}
+
+type panickingTemplate struct{}
+
+func (panickingTemplate) Name() string { return "custom" }
+
+func (panickingTemplate) Execute(w io.Writer, data any) error {
+	panic("renderer bug")
+}
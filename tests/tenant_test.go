@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type tenantKey struct{}
+
+func TestTenants(t *testing.T) {
+	SetTenantExtractor(func(ctx context.Context) string {
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		return tenant
+	})
+	defer SetTenantExtractor(nil)
+
+	t.Run("Tenant is extracted from the context", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+
+		var tenant string
+		tr := Try(func() {
+			ThrowInvalidOperation("x")
+		}, WithContext(ctx), WithObserver(ObserverFunc(func(event Event) {
+			tenant = event.Tenant
+		})))
+
+		if tr.Tenant() != "acme" || tenant != "acme" {
+			t.Errorf("Expected tenant acme, got result=%s event=%s", tr.Tenant(), tenant)
+		}
+	})
+
+	t.Run("Explicit tenant takes precedence", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+		tr := Try(func() {}, WithContext(ctx), WithTenant("globex"))
+
+		if tr.Tenant() != "globex" {
+			t.Errorf("Expected tenant globex, got %s", tr.Tenant())
+		}
+		if Try(func() {}).Tenant() != "" {
+			t.Error("Try without tenant should report none")
+		}
+	})
+
+	t.Run("Noisy tenant cannot exhaust the reporting budget of others", func(t *testing.T) {
+		SetTenantBudget(3, time.Minute)
+		defer SetTenantBudget(0, 0)
+
+		delivered := make(map[string]int)
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled {
+				delivered[event.Tenant]++
+			}
+		}))
+		defer remove()
+
+		for i := 0; i < 10; i++ {
+			Try(func() { ThrowInvalidOperation("noisy") }, WithTenant("noisy")).End()
+		}
+		Try(func() { ThrowInvalidOperation("quiet") }, WithTenant("quiet")).End()
+		Try(func() { ThrowInvalidOperation("untagged") }).End()
+
+		if delivered["noisy"] > 3 {
+			t.Errorf("Noisy tenant should be capped, delivered %d", delivered["noisy"])
+		}
+		if delivered["quiet"] != 1 || delivered[""] != 1 {
+			t.Errorf("Other tenants should be unaffected: %v", delivered)
+		}
+		if TenantDropped("noisy") == 0 {
+			t.Error("Dropped events should be counted")
+		}
+	})
+
+	t.Run("Duplicate suppression is partitioned by tenant", func(t *testing.T) {
+		SetDuplicateWindow(time.Minute)
+		defer SetDuplicateWindow(0)
+
+		var delivered int
+		remove := AddObserver(ObserverFunc(func(event Event) {
+			if event.Kind == EventUnhandled {
+				delivered++
+			}
+		}))
+		defer remove()
+
+		for _, tenant := range []string{"acme", "acme", "globex"} {
+			Try(func() { ThrowInvalidOperation("retry") }, WithTenant(tenant), WithIdempotencyKey("job-1")).End()
+		}
+
+		if delivered != 2 {
+			t.Errorf("Expected one event per tenant, got %d", delivered)
+		}
+	})
+}
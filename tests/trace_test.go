@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type spanKey struct{}
+
+type recordedSpanEvent struct {
+	span  string
+	name  string
+	attrs map[string]string
+}
+
+func TestTraceObserver(t *testing.T) {
+	record := func(fraction float64) (Observer, *[]recordedSpanEvent) {
+		var events []recordedSpanEvent
+		return TraceObserver(TraceConfig{
+			HandledFraction: fraction,
+			AddEvent: func(ctx context.Context, name string, attrs []slog.Attr) {
+				span, _ := ctx.Value(spanKey{}).(string)
+				values := make(map[string]string, len(attrs))
+				for _, a := range attrs {
+					values[a.Key] = a.Value.String()
+				}
+				events = append(events, recordedSpanEvent{span: span, name: name, attrs: values})
+			},
+		}), &events
+	}
+	ctx := context.WithValue(context.Background(), spanKey{}, "span-1")
+
+	t.Run("Unhandled exceptions are always recorded", func(t *testing.T) {
+		observer, events := record(0)
+		Try(func() { ThrowInvalidOperation("boom") }, WithContext(ctx), WithObserver(observer)).End()
+		Try(func() { ThrowInvalidOperation("boom") }, WithObserver(observer)).Any(func(Exception) {})
+
+		if len(*events) != 1 {
+			t.Fatalf("Expected only the unhandled exception, got %d events", len(*events))
+		}
+		event := (*events)[0]
+		if event.span != "span-1" || event.name != "exception" {
+			t.Errorf("Unexpected event: %+v", event)
+		}
+		if event.attrs["exception.type"] != "InvalidOperationException" || event.attrs["exception.handled"] != "false" {
+			t.Errorf("Unexpected attributes: %v", event.attrs)
+		}
+		if event.attrs["exception.stacktrace"] == "" {
+			t.Error("Expected the stack trace")
+		}
+	})
+
+	t.Run("Handled exceptions are sampled", func(t *testing.T) {
+		observer, events := record(1)
+		Try(func() { ThrowArgumentNull("id", "") }, WithContext(ctx), WithObserver(observer)).Any(func(Exception) {})
+		if len(*events) != 1 || (*events)[0].attrs["exception.handled"] != "true" {
+			t.Fatalf("Expected one handled event, got %+v", *events)
+		}
+
+		observer, events = record(0.5)
+		for i := 0; i < 1000; i++ {
+			Try(func() { ThrowArgumentNull("id", "") }, WithObserver(observer)).Any(func(Exception) {})
+		}
+		if n := len(*events); n < 350 || n > 650 {
+			t.Errorf("Expected about half of the handled exceptions, got %d", n)
+		}
+	})
+}
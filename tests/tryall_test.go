@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestTryAll(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var ran atomic.Int32
+		result := TryAll(func() { ran.Add(1) }, func() { ran.Add(1) })
+		if result.HasException() || ran.Load() != 2 {
+			t.Errorf("Expected both blocks to run without exception, ran %d", ran.Load())
+		}
+	})
+
+	t.Run("Failures are aggregated in block order", func(t *testing.T) {
+		var types []string
+		TryAll(
+			func() {
+				time.Sleep(5 * time.Millisecond)
+				ThrowArgumentNull("id", "missing")
+			},
+			func() {},
+			func() { ThrowInvalidOperation("closed") },
+		).Handle(
+			Handler[AggregateException](func(ex AggregateException, full Exception) {
+				for _, failure := range ex.Exceptions {
+					types = append(types, failure.TypeName())
+				}
+			}),
+		)
+		if len(types) != 2 || types[0] != "ArgumentNullException" || types[1] != "InvalidOperationException" {
+			t.Errorf("Unexpected failures %v", types)
+		}
+	})
+
+	t.Run("Fail fast cancels the other blocks", func(t *testing.T) {
+		start := time.Now()
+		ex := TryAllFailFast(context.Background(),
+			func(ctx context.Context) { ThrowInvalidOperation("first") },
+			func(ctx context.Context) {
+				ReceiveCtx(ctx, make(chan int))
+			},
+		).GetException()
+
+		aggregate, ok := ex.Type.(AggregateException)
+		if !ok || len(aggregate.Exceptions) != 1 || aggregate.Exceptions[0].TypeName() != "InvalidOperationException" {
+			t.Fatalf("Expected only the first failure, got %v", ex)
+		}
+		if time.Since(start) > time.Second {
+			t.Error("The blocked block should have been canceled")
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func parsePort(s string) int {
+	port, err := strconv.Atoi(s)
+	ThrowIfError(err)
+	return port
+}
+
+func TestTry1(t *testing.T) {
+	t.Run("Value of a successful block", func(t *testing.T) {
+		result := Try1(func() int { return parsePort("9090") })
+		if port, ok := result.Value(); !ok || port != 9090 {
+			t.Errorf("Expected 9090, got %d (%v)", port, ok)
+		}
+		if result.OrElse(8080) != 9090 {
+			t.Error("OrElse should return the value")
+		}
+	})
+
+	t.Run("Fallback after handling", func(t *testing.T) {
+		var handled bool
+		port := Try1(func() int {
+			return parsePort("http")
+		}).Handle(
+			Handler[ParseException](func(ex ParseException, full Exception) { handled = true }),
+		).Finally(func() {}).OrElse(8080)
+
+		if port != 8080 || !handled {
+			t.Errorf("Expected the fallback after handling, got %d (handled %v)", port, handled)
+		}
+	})
+
+	t.Run("Catch through the embedded TryResult", func(t *testing.T) {
+		result := Try1(func() string {
+			ThrowArgumentNull("name", "")
+			return "unreachable"
+		})
+		Catch(result.TryResult, func(ex ArgumentNullException, full Exception) {})
+
+		if value, ok := result.Value(); ok || value != "" {
+			t.Errorf("Expected no value, got %q", value)
+		}
+		if result.Report().Outcome != OutcomeHandled {
+			t.Errorf("Expected a handled outcome, got %s", result.Report().Outcome)
+		}
+	})
+}
+
+func TestTry2(t *testing.T) {
+	host, port := Try2(func() (string, int) {
+		return "localhost", parsePort("5432")
+	}).Any(func(Exception) {}).OrElse("db", 5432)
+	if host != "localhost" || port != 5432 {
+		t.Errorf("Unexpected values: %s:%d", host, port)
+	}
+
+	result := Try2(func() (string, int) {
+		return "localhost", parsePort("pg")
+	}).End()
+	if _, _, ok := result.Values(); ok {
+		t.Error("Values should report the failure")
+	}
+	if host, port := result.OrElse("db", 5432); host != "db" || port != 5432 {
+		t.Errorf("Unexpected fallbacks: %s:%d", host, port)
+	}
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type pluginErrorA struct{ SimpleException }
+type pluginErrorB struct{ SimpleException }
+type pluginErrorC struct{ SimpleException }
+type pluginErrorD struct{ SimpleException }
+type pluginErrorE struct{ SimpleException }
+type pluginErrorF struct{ SimpleException }
+
+func TestTypeCacheBound(t *testing.T) {
+	SetTypeCacheLimit(4)
+	defer SetTypeCacheLimit(DefaultTypeCacheLimit)
+
+	handle := func(ex ExceptionType) {
+		Try(func() { Throw(ex) }).Handle(
+			Handler[NetworkException](func(ex NetworkException, full Exception) {}),
+			HandlerAny(func(Exception) {}),
+		)
+	}
+
+	before := TypeCacheStats()
+	for _, ex := range []ExceptionType{pluginErrorA{}, pluginErrorB{}, pluginErrorC{}, pluginErrorD{}, pluginErrorE{}, pluginErrorF{}} {
+		handle(ex)
+	}
+	handle(pluginErrorF{})
+
+	stats := TypeCacheStats()
+	if stats.Entries-stats.Warm > 4 {
+		t.Errorf("Expected at most 4 entries beside warm ones, got %+v", stats)
+	}
+	if stats.Evictions <= before.Evictions || stats.Hits <= before.Hits || stats.Misses < before.Misses+6 {
+		t.Errorf("Expected evictions, hits and misses to be counted, got %+v after %+v", stats, before)
+	}
+	if rate := stats.HitRate(); rate <= 0 || rate >= 1 {
+		t.Errorf("Unexpected hit rate %v", rate)
+	}
+}
+
+func TestTypeCacheWarmEntries(t *testing.T) {
+	Preload[ArgumentException]()
+	Preload[ArgumentNullException]()
+
+	SetTypeCacheLimit(2)
+	defer SetTypeCacheLimit(DefaultTypeCacheLimit)
+	for _, ex := range []ExceptionType{pluginErrorA{}, pluginErrorB{}, pluginErrorC{}} {
+		Try(func() { Throw(ex) }).Handle(
+			Handler[NetworkException](func(ex NetworkException, full Exception) {}),
+			HandlerAny(func(Exception) {}),
+		)
+	}
+
+	var caught bool
+	Try(func() {
+		ThrowArgumentNull("id", "required")
+	}).Handle(Handler[ArgumentException](func(ex ArgumentException, full Exception) {
+		caught = true
+	}))
+	if !caught {
+		t.Error("Preloaded interface handlers should catch the types implementing them")
+	}
+	if stats := TypeCacheStats(); stats.Warm < 4 {
+		t.Errorf("Preloaded matches should stay cached, got %+v", stats)
+	}
+}
@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+type QuotaExceededException struct {
+	AutoTypeName[QuotaExceededException]
+	Limit int
+}
+
+func (e QuotaExceededException) Error() string {
+	return fmt.Sprintf("QuotaExceededException: limit %d reached", e.Limit)
+}
+
+func TestTypeNameOf(t *testing.T) {
+	cases := map[string]string{
+		TypeNameOf[ArgumentNullException]():  "ArgumentNullException",
+		TypeNameOf[*NetworkException]():      "NetworkException",
+		TypeNameOf[QuotaExceededException](): "QuotaExceededException",
+		TypeNameOf[[]int]():                  "[]int",
+	}
+	for got, want := range cases {
+		if got != want {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+	}
+}
+
+func TestAutoTypeName(t *testing.T) {
+	t.Run("Embedded TypeName uses the outer type", func(t *testing.T) {
+		ex := QuotaExceededException{Limit: 10}
+		if ex.TypeName() != "QuotaExceededException" {
+			t.Errorf("Unexpected TypeName: %s", ex.TypeName())
+		}
+	})
+
+	t.Run("Works with throw and catch", func(t *testing.T) {
+		var limit int
+		tr := Try(func() {
+			Throw(QuotaExceededException{Limit: 5})
+		}).Handle(
+			Handler[QuotaExceededException](func(ex QuotaExceededException, full Exception) {
+				limit = ex.Limit
+			}),
+		)
+
+		if limit != 5 {
+			t.Error("Custom exception should be caught by type")
+		}
+		if tr.GetException().TypeName() != "QuotaExceededException" {
+			t.Errorf("Unexpected wrapper TypeName: %s", tr.GetException().TypeName())
+		}
+	})
+}
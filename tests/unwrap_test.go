@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+// escape returns the exception raised by block as a plain error, as an API
+// boundary would
+func escape(block func()) error {
+	var err error
+	Try(block).Any(func(ex Exception) {
+		err = fmt.Errorf("boundary: %w", ex)
+	})
+	return err
+}
+
+func TestExceptionUnwrap(t *testing.T) {
+	t.Run("Is finds the cause of a built-in type", func(t *testing.T) {
+		err := escape(func() { ThrowIOError("read", "read failed", io.ErrUnexpectedEOF) })
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("Expected io.ErrUnexpectedEOF in %v", err)
+		}
+	})
+
+	t.Run("Is finds a translated error", func(t *testing.T) {
+		err := escape(func() { panic(fmt.Errorf("open config: %w", fs.ErrNotExist)) })
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected fs.ErrNotExist in %v", err)
+		}
+	})
+
+	t.Run("Is and As walk the inner chain", func(t *testing.T) {
+		err := escape(func() {
+			Try(func() {
+				ThrowFileError("orders.csv", "missing", fs.ErrNotExist)
+			}).Any(func(inner Exception) {
+				ThrowWithInner(IOException{Operation: "import", Message: "failed", Cause: io.ErrClosedPipe}, &inner)
+			})
+		})
+
+		if !errors.Is(err, io.ErrClosedPipe) || !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected both causes in %v", err)
+		}
+		var fileEx FileException
+		if !errors.As(err, &fileEx) || fileEx.Filename != "orders.csv" {
+			t.Errorf("Expected the inner FileException, got %+v", fileEx)
+		}
+		var ex Exception
+		if !errors.As(err, &ex) || ex.TypeName() != "IOException" {
+			t.Errorf("Expected the outer exception, got %v", ex)
+		}
+	})
+
+	t.Run("As matches interfaces and rejects other types", func(t *testing.T) {
+		err := escape(func() { Throw(TimeoutException{Operation: "query", Message: "slow"}) })
+
+		var timeout TimeoutException
+		if !errors.As(err, &timeout) || timeout.Operation != "query" {
+			t.Errorf("Expected TimeoutException, got %+v", timeout)
+		}
+		var exceptionType ExceptionType
+		if !errors.As(err, &exceptionType) || exceptionType.TypeName() != "TimeoutException" {
+			t.Errorf("Expected the exception type, got %v", exceptionType)
+		}
+		var network NetworkException
+		if errors.As(err, &network) {
+			t.Error("NetworkException should not match")
+		}
+	})
+
+	t.Run("Unwrap is nil without cause or inner", func(t *testing.T) {
+		ex := Exception{Type: InvalidOperationException{Message: "no"}}
+		if ex.Unwrap() != nil {
+			t.Errorf("Expected nil, got %v", ex.Unwrap())
+		}
+	})
+}
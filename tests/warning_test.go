@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestReport(t *testing.T) {
+	t.Run("Warnings reach observers without unwinding", func(t *testing.T) {
+		var events []Event
+		observer := ObserverFunc(func(event Event) { events = append(events, event) })
+
+		tr := Try(func() {
+			Report(InvalidOperationException{Message: "falling back to defaults"},
+				WithName("config.load"), WithTenant("acme"), WithObserver(observer))
+		})
+
+		if tr.HasException() {
+			t.Fatal("Report should not unwind")
+		}
+		if len(events) != 1 {
+			t.Fatalf("Expected one event, got %d", len(events))
+		}
+		event := events[0]
+		if event.Kind != EventWarning || event.Kind.String() != "warning" {
+			t.Errorf("Expected EventWarning, got %s", event.Kind)
+		}
+		if event.Name != "config.load" || event.Tenant != "acme" {
+			t.Errorf("Unexpected event metadata: %+v", event)
+		}
+		if event.Exception.TypeName() != "InvalidOperationException" {
+			t.Errorf("Unexpected exception: %s", event.Exception.TypeName())
+		}
+		if !strings.Contains(event.Exception.Origin, "warning_test.go") {
+			t.Errorf("Origin should point at the caller, got %q", event.Exception.Origin)
+		}
+	})
+
+	t.Run("WithoutStack drops the stack trace", func(t *testing.T) {
+		var warning *Exception
+		Report(InvalidOperationException{Message: "slow path"}, WithoutStack(),
+			WithObserver(ObserverFunc(func(event Event) { warning = event.Exception })))
+
+		if warning == nil || warning.StackTrace != nil {
+			t.Errorf("Expected a warning without stack, got %+v", warning)
+		}
+	})
+
+	t.Run("SlogObserver logs warnings at warn level", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+		Report(InvalidOperationException{Message: "deprecated flag"}, WithObserver(SlogObserver(logger)))
+
+		if !strings.Contains(buf.String(), "level=WARN") || !strings.Contains(buf.String(), `msg="exception reported"`) {
+			t.Errorf("Unexpected log output: %s", buf.String())
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"io/fs"
+	"strconv"
+	"testing"
+
+	. "github.com/bencz/go-exceptions"
+)
+
+func TestThrowError(t *testing.T) {
+	t.Run("Wraps without translation", func(t *testing.T) {
+		_, parseErr := strconv.Atoi("x")
+		ex := Try(func() { ThrowError(parseErr) }).Any(func(Exception) {}).GetException()
+
+		wrapped, ok := ex.Type.(WrappedErrorException)
+		if !ok || wrapped.Err != parseErr {
+			t.Fatalf("Expected WrappedErrorException, got %s", ex.TypeName())
+		}
+		var numErr *strconv.NumError
+		if !errors.As(ex, &numErr) || len(ex.StackTrace) == 0 {
+			t.Error("Expected the original error and a stack trace")
+		}
+	})
+
+	t.Run("Errorf keeps wrapped errors", func(t *testing.T) {
+		var matched bool
+		Try(func() {
+			ThrowErrorf("loading %s: %w", "config.yaml", fs.ErrNotExist)
+		}).Handle(
+			HandlerSentinel(fs.ErrNotExist, func(ex Exception) {
+				matched = ex.Error() == "WrappedErrorException: loading config.yaml: file does not exist"
+			}),
+		)
+		if !matched {
+			t.Error("Expected the sentinel to match")
+		}
+	})
+
+	t.Run("Nil error", func(t *testing.T) {
+		ex := Try(func() { ThrowError(nil) }).Any(func(Exception) {}).GetException()
+		if ex.TypeName() != "ArgumentNullException" {
+			t.Errorf("Expected ArgumentNullException, got %s", ex.TypeName())
+		}
+	})
+
+	t.Run("Gob keeps the message", func(t *testing.T) {
+		ex := Try(func() { ThrowError(fs.ErrPermission) }).Any(func(Exception) {}).GetException()
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(ex); err != nil {
+			t.Fatal(err)
+		}
+		var decoded Exception
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Error() != ex.Error() {
+			t.Errorf("Expected %q, got %q", ex.Error(), decoded.Error())
+		}
+	})
+}
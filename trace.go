@@ -0,0 +1,62 @@
+package goexceptions
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+)
+
+// ============================================================================
+// TRACING: Span events for unhandled and sampled handled exceptions
+// ============================================================================
+
+// TraceEventName is the span event name used for exceptions, as in OpenTelemetry's
+// semantic conventions
+const TraceEventName = "exception"
+
+// TraceConfig configures TraceObserver
+type TraceConfig struct {
+	// HandledFraction is the fraction of handled exceptions recorded, between 0 and 1.
+	// Unhandled exceptions are always recorded.
+	HandledFraction float64
+
+	// AddEvent adds an event to the span found in ctx, the context given to the Try
+	// with WithContext
+	AddEvent func(ctx context.Context, name string, attrs []slog.Attr)
+}
+
+// TraceObserver returns an observer that records exceptions as span events through
+// config.AddEvent, keeping this package free of tracing dependencies. Sampling
+// handled exceptions gives visibility into recovery paths that never fail a
+// request. With OpenTelemetry:
+//
+//	AddObserver(TraceObserver(TraceConfig{
+//	    HandledFraction: 0.01,
+//	    AddEvent: func(ctx context.Context, name string, attrs []slog.Attr) {
+//	        kvs := make([]attribute.KeyValue, len(attrs))
+//	        for i, a := range attrs {
+//	            kvs[i] = attribute.String(a.Key, a.Value.String())
+//	        }
+//	        trace.SpanFromContext(ctx).AddEvent(name, trace.WithAttributes(kvs...))
+//	    },
+//	}))
+func TraceObserver(config TraceConfig) Observer {
+	return ObserverFunc(func(event Event) {
+		switch event.Kind {
+		case EventUnhandled:
+		case EventHandled:
+			if config.HandledFraction <= 0 || rand.Float64() >= config.HandledFraction {
+				return
+			}
+		default:
+			return
+		}
+
+		attrs := append(eventAttrs(event), slog.Bool("exception.handled", event.Kind == EventHandled))
+		if ex := event.Exception; ex != nil && len(ex.StackTrace) > 0 {
+			attrs = append(attrs, slog.String("exception.stacktrace", strings.Join(ex.StackTrace, "\n")))
+		}
+		config.AddEvent(event.Context, TraceEventName, attrs)
+	})
+}
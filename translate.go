@@ -0,0 +1,98 @@
+package goexceptions
+
+import (
+	"sync"
+)
+
+// ============================================================================
+// TRANSLATORS: Convert standard errors into exception types
+// ============================================================================
+
+// Translator converts an error into an exception type. It returns false when it
+// does not recognize the error.
+type Translator func(err error) (ExceptionType, bool)
+
+type translatorEntry struct {
+	translate Translator
+}
+
+var translatorsMutex sync.RWMutex
+var translators []*translatorEntry
+
+// RegisterTranslator adds a translator consulted before the built-in ones and
+// returns a function that removes it. Translators registered later run first.
+func RegisterTranslator(translator Translator) (remove func()) {
+	entry := &translatorEntry{translate: translator}
+
+	translatorsMutex.Lock()
+	translators = append([]*translatorEntry{entry}, translators...)
+	translatorsMutex.Unlock()
+
+	return func() {
+		translatorsMutex.Lock()
+		defer translatorsMutex.Unlock()
+		for i, existing := range translators {
+			if existing == entry {
+				translators = append(translators[:i:i], translators[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// TranslateError converts err into an exception type using the registered and
+// built-in translators. Unrecognized errors become InvalidOperationException.
+func TranslateError(err error) ExceptionType {
+	if ex, ok := err.(ExceptionType); ok {
+		return ex
+	}
+
+	translated := translateError(err)
+	dryRunTranslate(err, translated)
+	return translated
+}
+
+func translateError(err error) ExceptionType {
+	translatorsMutex.RLock()
+	current := translators
+	translatorsMutex.RUnlock()
+
+	for _, entry := range current {
+		if ex, ok := entry.translate(err); ok {
+			return ex
+		}
+	}
+	for _, translate := range builtinTranslators {
+		if ex, ok := translate(err); ok {
+			return ex
+		}
+	}
+	return InvalidOperationException{Message: err.Error()}
+}
+
+// ThrowIfError throws the translated exception when err is not nil. The original
+// error is kept as the cause, so HandlerSentinel can still match it.
+func ThrowIfError(err error) {
+	if err != nil {
+		throwTranslated(err)
+	}
+}
+
+// throwTranslated must be called directly by the public throw helper, like
+// Throw, so the captured stack starts at the same depth
+func throwTranslated(err error) {
+	ex := newException(TranslateError(err), nil)
+	ex.cause = err
+	if recorded := StackTraceOf(err); recorded != nil {
+		ex.StackTrace = recorded
+	}
+	panic(ex)
+}
+
+// builtinTranslators cover standard library errors
+var builtinTranslators = []Translator{
+	translateParseError,
+	translateRuntimeError,
+	translateConcurrencyError,
+	translateContextError,
+}
@@ -0,0 +1,81 @@
+package goexceptions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ============================================================================
+// TRY ALL: Concurrent blocks with their failures aggregated
+// ============================================================================
+
+// TryAll runs blocks concurrently, each in its own goroutine with its own exception
+// capture, and waits for all of them. When any block throws, the result holds an
+// AggregateException of every failure, in the order of the blocks:
+//
+//	TryAll(loadUser, loadOrders, loadPrefs).Handle(
+//	    Handler[AggregateException](func(ex AggregateException, full Exception) { ... }),
+//	)
+func TryAll(blocks ...func()) *TryResult {
+	contextBlocks := make([]func(context.Context), len(blocks))
+	for i, block := range blocks {
+		contextBlocks[i] = func(context.Context) { block() }
+	}
+	return tryAll(context.Background(), false, contextBlocks)
+}
+
+// TryAllFailFast is TryAll for blocks taking a context, which is canceled as soon as
+// one block throws so the others can stop early. OperationCanceledExceptions thrown
+// after that cancellation are consequences of the first failure and are left out of
+// the AggregateException.
+func TryAllFailFast(ctx context.Context, blocks ...func(ctx context.Context)) *TryResult {
+	return tryAll(ctx, true, blocks)
+}
+
+func tryAll(ctx context.Context, failFast bool, blocks []func(context.Context)) *TryResult {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	failures := make([]*Exception, len(blocks))
+	var failedFast bool
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for i, block := range blocks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ex := Try(func() { block(ctx) }).settle()
+			if ex == nil {
+				return
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if _, canceled := ex.Type.(OperationCanceledException); canceled && failedFast {
+				return
+			}
+			failures[i] = ex
+			if failFast && !failedFast {
+				failedFast = true
+				cancel(ex.ToError())
+			}
+		}()
+	}
+	wg.Wait()
+
+	var failed []*Exception
+	for _, ex := range failures {
+		if ex != nil {
+			failed = append(failed, ex)
+		}
+	}
+	return Try(func() {
+		if len(failed) > 0 {
+			Throw(AggregateException{
+				Message:    fmt.Sprintf("%d of %d blocks failed", len(failed), len(blocks)),
+				Exceptions: failed,
+			})
+		}
+	})
+}
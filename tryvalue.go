@@ -0,0 +1,149 @@
+package goexceptions
+
+import "time"
+
+// ============================================================================
+// TRY WITH VALUES: Blocks that return results
+// ============================================================================
+
+// TryResultT is the result of Try1: the TryResult plus the value returned by the
+// block. Its chain methods keep the value; for Catch, pass the embedded TryResult.
+type TryResultT[T any] struct {
+	*TryResult
+	value T
+}
+
+// Try1 runs block like Try and keeps the value it returns, so it does not have to be
+// smuggled out through a closure variable:
+//
+//	port := Try1(func() int { return parsePort(s) }).Any(logIt).OrElse(8080)
+func Try1[T any](block func() T, opts ...TryOption) *TryResultT[T] {
+	result := &TryResultT[T]{}
+	result.TryResult = Try(func() {
+		result.value = block()
+	}, opts...)
+	return result
+}
+
+// Handle is TryResult.Handle, keeping the value
+func (r *TryResultT[T]) Handle(handlers ...ExceptionHandler) *TryResultT[T] {
+	r.TryResult.Handle(handlers...)
+	return r
+}
+
+// HandleWithin is TryResult.HandleWithin, keeping the value
+func (r *TryResultT[T]) HandleWithin(d time.Duration, handlers ...ExceptionHandler) *TryResultT[T] {
+	r.TryResult.HandleWithin(d, handlers...)
+	return r
+}
+
+// Any is TryResult.Any, keeping the value
+func (r *TryResultT[T]) Any(handler func(Exception)) *TryResultT[T] {
+	r.TryResult.Any(handler)
+	return r
+}
+
+// Else is TryResult.Else, passing the block's value to onSuccess
+func (r *TryResultT[T]) Else(onSuccess func(T)) *TryResultT[T] {
+	r.TryResult.Else(func() { onSuccess(r.value) })
+	return r
+}
+
+// Finally is TryResult.Finally, keeping the value
+func (r *TryResultT[T]) Finally(cleanup func()) *TryResultT[T] {
+	r.TryResult.Finally(cleanup)
+	return r
+}
+
+// End is TryResult.End, keeping the value
+func (r *TryResultT[T]) End() *TryResultT[T] {
+	r.TryResult.End()
+	return r
+}
+
+// Value returns the block's value, and false if the block threw
+func (r *TryResultT[T]) Value() (T, bool) {
+	if r.HasException() {
+		var zero T
+		return zero, false
+	}
+	return r.value, true
+}
+
+// OrElse returns the block's value, or fallback if the block threw
+func (r *TryResultT[T]) OrElse(fallback T) T {
+	if r.HasException() {
+		return fallback
+	}
+	return r.value
+}
+
+// TryResultT2 is the result of Try2
+type TryResultT2[T1, T2 any] struct {
+	*TryResult
+	first  T1
+	second T2
+}
+
+// Try2 is Try1 for blocks returning two values
+func Try2[T1, T2 any](block func() (T1, T2), opts ...TryOption) *TryResultT2[T1, T2] {
+	result := &TryResultT2[T1, T2]{}
+	result.TryResult = Try(func() {
+		result.first, result.second = block()
+	}, opts...)
+	return result
+}
+
+// Handle is TryResult.Handle, keeping the values
+func (r *TryResultT2[T1, T2]) Handle(handlers ...ExceptionHandler) *TryResultT2[T1, T2] {
+	r.TryResult.Handle(handlers...)
+	return r
+}
+
+// HandleWithin is TryResult.HandleWithin, keeping the values
+func (r *TryResultT2[T1, T2]) HandleWithin(d time.Duration, handlers ...ExceptionHandler) *TryResultT2[T1, T2] {
+	r.TryResult.HandleWithin(d, handlers...)
+	return r
+}
+
+// Any is TryResult.Any, keeping the values
+func (r *TryResultT2[T1, T2]) Any(handler func(Exception)) *TryResultT2[T1, T2] {
+	r.TryResult.Any(handler)
+	return r
+}
+
+// Else is TryResult.Else, passing the block's values to onSuccess
+func (r *TryResultT2[T1, T2]) Else(onSuccess func(T1, T2)) *TryResultT2[T1, T2] {
+	r.TryResult.Else(func() { onSuccess(r.first, r.second) })
+	return r
+}
+
+// Finally is TryResult.Finally, keeping the values
+func (r *TryResultT2[T1, T2]) Finally(cleanup func()) *TryResultT2[T1, T2] {
+	r.TryResult.Finally(cleanup)
+	return r
+}
+
+// End is TryResult.End, keeping the values
+func (r *TryResultT2[T1, T2]) End() *TryResultT2[T1, T2] {
+	r.TryResult.End()
+	return r
+}
+
+// Values returns the block's values, and false if the block threw
+func (r *TryResultT2[T1, T2]) Values() (T1, T2, bool) {
+	if r.HasException() {
+		var first T1
+		var second T2
+		return first, second, false
+	}
+	return r.first, r.second, true
+}
+
+// OrElse returns the block's values, or the fallbacks if the block threw
+func (r *TryResultT2[T1, T2]) OrElse(first T1, second T2) (T1, T2) {
+	if r.HasException() {
+		return first, second
+	}
+	return r.first, r.second
+}
@@ -0,0 +1,131 @@
+package goexceptions
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// ============================================================================
+// TYPE CACHE: Bounded memory of handler/exception type matches
+// ============================================================================
+
+// typePair keys the cache by both the expected and the actual type, so a mismatch
+// recorded for one exception type never hides a later match for another
+type typePair struct {
+	expected reflect.Type
+	actual   reflect.Type
+}
+
+// The cache keeps two generations. New entries go to typeCache; when it holds half
+// the limit it becomes typeCachePrevious, whose entries are dropped unless a lookup
+// promotes them back first. A burst of new types therefore only evicts entries not
+// used since the last rotation. Preloaded entries live in typeCacheWarm and are
+// never evicted.
+var typeCache = make(map[typePair]bool)
+var typeCachePrevious = make(map[typePair]bool)
+var typeCacheWarm = make(map[typePair]bool)
+var typeCacheMutex sync.RWMutex
+
+// DefaultTypeCacheLimit is the number of type matches cached, preloaded ones aside,
+// until SetTypeCacheLimit changes it
+const DefaultTypeCacheLimit = 4096
+
+var typeCacheLimit atomic.Int64
+
+func init() {
+	typeCacheLimit.Store(DefaultTypeCacheLimit)
+}
+
+var typeCacheWrites atomic.Int64
+var typeCacheHits atomic.Int64
+var typeCacheMisses atomic.Int64
+var typeCacheEvictions atomic.Int64
+
+// CacheStats describes the use of a cache since the process started
+type CacheStats struct {
+	Entries   int // cached entries, including Warm ones
+	Warm      int // preloaded entries, which are never evicted
+	Limit     int // bound of the entries that are not Warm, 0 if unbounded
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
+// HitRate returns the share of lookups answered by the cache
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// TypeCacheStats reports the use of the cache of handler/exception type matches,
+// which grows with the number of distinct exception types handled, so hosts loading
+// many plugins can size it with SetTypeCacheLimit
+func TypeCacheStats() CacheStats {
+	typeCacheMutex.RLock()
+	defer typeCacheMutex.RUnlock()
+	return CacheStats{
+		Entries:   len(typeCache) + len(typeCachePrevious) + len(typeCacheWarm),
+		Warm:      len(typeCacheWarm),
+		Limit:     int(typeCacheLimit.Load()),
+		Hits:      typeCacheHits.Load(),
+		Misses:    typeCacheMisses.Load(),
+		Evictions: typeCacheEvictions.Load(),
+	}
+}
+
+// SetTypeCacheLimit bounds the type matches cached, preloaded ones aside (see
+// Preload). Zero or less removes the bound. The default is DefaultTypeCacheLimit.
+func SetTypeCacheLimit(limit int) {
+	typeCacheLimit.Store(int64(max(limit, 0)))
+}
+
+// lookupTypeMatch returns the cached match of key, promoting it out of the previous
+// generation when found there
+func lookupTypeMatch(key typePair) (match, cached bool) {
+	typeCacheMutex.RLock()
+	match, cached = typeCache[key]
+	if !cached {
+		match, cached = typeCacheWarm[key]
+	}
+	promote := false
+	if !cached {
+		match, cached = typeCachePrevious[key]
+		promote = cached
+	}
+	typeCacheMutex.RUnlock()
+
+	if !cached {
+		typeCacheMisses.Add(1)
+		return false, false
+	}
+	typeCacheHits.Add(1)
+	if promote {
+		storeTypeMatch(key, match)
+	}
+	return match, true
+}
+
+func storeTypeMatch(key typePair, match bool) {
+	typeCacheMutex.Lock()
+	if limit := int(typeCacheLimit.Load()); limit > 0 && len(typeCache) >= max(limit/2, 1) {
+		typeCacheEvictions.Add(int64(len(typeCachePrevious)))
+		typeCachePrevious = typeCache
+		typeCache = make(map[typePair]bool)
+	}
+	typeCache[key] = match
+	typeCacheMutex.Unlock()
+	typeCacheWrites.Add(1)
+}
+
+// storeWarmTypeMatch caches a match that is never evicted
+func storeWarmTypeMatch(key typePair) {
+	typeCacheMutex.Lock()
+	defer typeCacheMutex.Unlock()
+	if _, exists := typeCacheWarm[key]; !exists {
+		typeCacheWarm[key] = computeTypeMatch(key)
+		typeCacheWrites.Add(1)
+	}
+}
@@ -0,0 +1,42 @@
+package goexceptions
+
+import "reflect"
+
+// ============================================================================
+// TYPE NAMES: Derive TypeName from the Go type
+// ============================================================================
+
+// TypeNameOf returns the default TypeName for T: the Go type name without its
+// package, dereferencing pointers
+func TypeNameOf[T any]() string {
+	return typeNameOf(getTypeOf[T]())
+}
+
+func typeNameOf(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if name := t.Name(); name != "" {
+		return name
+	}
+	return t.String()
+}
+
+// AutoTypeName provides a TypeName method derived from T. Embed it in a custom
+// exception, parameterized with the exception itself, so the type only needs its
+// fields and an Error method:
+//
+//	type QuotaExceededException struct {
+//	    AutoTypeName[QuotaExceededException]
+//	    Limit int
+//	}
+//
+//	func (e QuotaExceededException) Error() string {
+//	    return fmt.Sprintf("QuotaExceededException: limit %d reached", e.Limit)
+//	}
+type AutoTypeName[T any] struct{}
+
+// TypeName returns TypeNameOf[T]()
+func (AutoTypeName[T]) TypeName() string {
+	return TypeNameOf[T]()
+}
@@ -0,0 +1,21 @@
+package goexceptions
+
+// ============================================================================
+// WARNINGS: Report exception-shaped problems without unwinding
+// ============================================================================
+
+// Report delivers warning to observers as an EventWarning, without panicking. It is
+// meant for problems the caller can continue past, which would be too disruptive to
+// throw but deserve more structure than a log line. The exception carries the same
+// origin, stack and fingerprint as a thrown one. Options name the operation, attach
+// a tenant or context, or add a per-call observer, as they do for Try.
+func Report[T ExceptionType](warning T, opts ...TryOption) {
+	ex := newException(warning, nil)
+
+	tr := newTryResult(opts)
+	if tr.config.noStack {
+		ex.StackTrace = nil
+	}
+	tr.exception = &ex
+	tr.notify(EventWarning)
+}
@@ -0,0 +1,55 @@
+package goexceptions
+
+import "fmt"
+
+// ============================================================================
+// WRAPPED ERRORS: Throw any error without a dedicated exception type
+// ============================================================================
+
+// WrappedErrorException carries an error thrown with ThrowError or ThrowErrorf. Err is
+// the original error, which errors.Is, errors.As and HandlerSentinel see through.
+type WrappedErrorException struct {
+	Err error
+}
+
+func (e WrappedErrorException) Error() string {
+	if e.Err == nil {
+		return "WrappedErrorException: <nil>"
+	}
+	return fmt.Sprintf("WrappedErrorException: %s", e.Err.Error())
+}
+
+func (e WrappedErrorException) TypeName() string {
+	return "WrappedErrorException"
+}
+
+// Unwrap returns the original error
+func (e WrappedErrorException) Unwrap() error {
+	return e.Err
+}
+
+// ThrowError throws err as a WrappedErrorException, without going through the
+// translators like ThrowIfError does. A nil err is a programming error and throws
+// ArgumentNullException.
+func ThrowError(err error) {
+	if err == nil {
+		ThrowArgumentNull("err", "ThrowError needs an error")
+	}
+	throwWrapped(err)
+}
+
+// ThrowErrorf throws fmt.Errorf(format, args...) as a WrappedErrorException. Errors
+// wrapped with %w remain visible to errors.Is and errors.As.
+func ThrowErrorf(format string, args ...any) {
+	throwWrapped(fmt.Errorf(format, args...))
+}
+
+// throwWrapped must be called directly by the public throw helper, like Throw, so
+// the captured stack starts at the same depth
+func throwWrapped(err error) {
+	ex := newException(WrappedErrorException{Err: err}, nil)
+	if recorded := StackTraceOf(err); recorded != nil {
+		ex.StackTrace = recorded
+	}
+	panic(ex)
+}